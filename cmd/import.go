@@ -18,6 +18,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
@@ -29,32 +30,44 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/lifecycle"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/secrets"
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils/terraformoutput"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/transform"
 
 	"github.com/spf13/cobra"
 )
 
 type ImportOptions struct {
-	Resources     []string
-	Excludes      []string
-	PathPattern   string
-	PathOutput    string
-	State         string
-	Bucket        string
-	Profile       string
-	Verbose       bool
-	Zone          string
-	Regions       []string
-	Projects      []string
-	ResourceGroup string
-	Connect       bool
-	Compact       bool
-	Filter        []string
-	Plan          bool `json:"-"`
-	Output        string
-	NoSort        bool
-	RetryCount    int
-	RetrySleepMs  int
+	Resources            []string
+	Excludes             []string
+	PathPattern          string
+	PathOutput           string
+	State                string
+	Bucket               string
+	Profile              string
+	Verbose              bool
+	Zone                 string
+	Regions              []string
+	Projects             []string
+	ResourceGroup        string
+	ManagementGroup      string
+	Connect              bool
+	Compact              bool
+	Filter               []string
+	Plan                 bool `json:"-"`
+	Output               string
+	NoSort               bool
+	RetryCount           int
+	RetrySleepMs         int
+	Validate             bool
+	Transform            string
+	RedactSecrets        bool
+	StripSecrets         bool
+	IgnoreChanges        bool
+	LifecycleConfig      string
+	PreventDestroyLocked bool
+	InventoryFormat      string
 }
 
 const DefaultPathPattern = "{output}/{provider}/{service}/"
@@ -87,6 +100,11 @@ func newImportCmd() *cobra.Command {
 }
 
 func Import(provider terraformutils.ProviderGenerator, options ImportOptions, args []string) error {
+	if options.Output == "inventory" {
+		if err := terraformoutput.ValidateInventoryFormat(options.InventoryFormat); err != nil {
+			return err
+		}
+	}
 
 	providerWrapper, options, err := initOptionsAndWrapper(provider, options, args)
 	if err != nil {
@@ -95,11 +113,20 @@ func Import(provider terraformutils.ProviderGenerator, options ImportOptions, ar
 	defer providerWrapper.Kill()
 	providerMapping := terraformutils.NewProvidersMapping(provider)
 
+	transformRules, err := transform.LoadRules(provider, options.Transform)
+	if err != nil {
+		return err
+	}
+
 	err = initAllServicesResources(providerMapping, options, args, providerWrapper)
 	if err != nil {
 		return err
 	}
 
+	for resource := range providerMapping.Resources {
+		transformRules.Apply(transform.BeforeRefresh, resource)
+	}
+
 	err = terraformutils.RefreshResourcesByProvider(providerMapping, providerWrapper)
 	if err != nil {
 		return err
@@ -109,9 +136,40 @@ func Import(provider terraformutils.ProviderGenerator, options ImportOptions, ar
 	// change structs with additional data for each resource
 	providerMapping.CleanupProviders()
 
+	for resource := range providerMapping.Resources {
+		transformRules.Apply(transform.AfterRefresh, resource)
+	}
+
+	// the inventory lists the state attributes, secrets are always blanked there
+	stripSecrets := options.StripSecrets || options.Output == "inventory"
+	if options.RedactSecrets || stripSecrets {
+		detector := secrets.NewDetector(providerWrapper.GetSchema())
+		for resource := range providerMapping.Resources {
+			detector.Redact(resource, options.RedactSecrets, stripSecrets)
+		}
+	}
+
+	if options.IgnoreChanges || options.LifecycleConfig != "" || options.PreventDestroyLocked {
+		lifecycleConfig, err := lifecycle.LoadConfig(provider, options.LifecycleConfig)
+		if err != nil {
+			return err
+		}
+		resources := []*terraformutils.Resource{}
+		for resource := range providerMapping.Resources {
+			resources = append(resources, resource)
+		}
+		lifecycleConfig.Apply(provider, resources, options.IgnoreChanges || options.LifecycleConfig != "", options.PreventDestroyLocked)
+	}
+
 	err = importFromPlan(providerMapping, options, args)
+	if err != nil {
+		return err
+	}
 
-	return err
+	if options.Validate && !options.Plan && options.Output != "inventory" {
+		return validateImport(provider, options, providerMapping.GetResourcesByService(), providerWrapper)
+	}
+	return nil
 }
 
 func initOptionsAndWrapper(provider terraformutils.ProviderGenerator, options ImportOptions, args []string) (*providerwrapper.ProviderWrapper, ImportOptions, error) {
@@ -157,18 +215,47 @@ func initAllServicesResources(providersMapping *terraformutils.ProvidersMapping,
 
 	var failedServices []string
 
+	// services depending on the resources of the others go last
+	var services, dependentServices []string
+	supportedServices := providersMapping.GetBaseProvider().GetSupportedService()
 	for _, service := range options.Resources {
+		if _, ok := supportedServices[service].(terraformutils.ServiceWithImportedResources); ok {
+			dependentServices = append(dependentServices, service)
+		} else {
+			services = append(services, service)
+		}
+	}
+
+	for _, service := range services {
 		serviceProvider := providersMapping.AddServiceToProvider(service)
 		err := serviceProvider.Init(args)
 		if err != nil {
 			return err
 		}
-		err = initServiceResources(service, serviceProvider, options, providerWrapper)
+		err = initServiceResources(service, serviceProvider, options, providerWrapper, nil)
 		if err != nil {
 			failedServices = append(failedServices, service)
 		}
 	}
 
+	if len(dependentServices) > 0 {
+		var importedResources []terraformutils.Resource
+		for provider := range providersMapping.Providers {
+			importedResources = append(importedResources, provider.GetService().GetResources()...)
+		}
+		for _, service := range dependentServices {
+			serviceProvider := providersMapping.AddServiceToProvider(service)
+			err := serviceProvider.Init(args)
+			if err != nil {
+				return err
+			}
+			err = initServiceResources(service, serviceProvider, options, providerWrapper, importedResources)
+			if err != nil {
+				failedServices = append(failedServices, service)
+			}
+		}
+	}
+
 	// remove providers that failed to init their service
 	providersMapping.RemoveServices(failedServices)
 	providersMapping.ProcessResources(false)
@@ -198,13 +285,16 @@ func importFromPlan(providerMapping *terraformutils.ProvidersMapping, options Im
 }
 
 func initServiceResources(service string, provider terraformutils.ProviderGenerator,
-	options ImportOptions, providerWrapper *providerwrapper.ProviderWrapper) error {
+	options ImportOptions, providerWrapper *providerwrapper.ProviderWrapper, importedResources []terraformutils.Resource) error {
 	log.Println(provider.GetName() + " importing... " + service)
 	err := provider.InitService(service, options.Verbose)
 	if err != nil {
 		log.Printf("%s error importing %s, err: %s\n", provider.GetName(), service, err)
 		return err
 	}
+	if serviceWithImportedResources, ok := provider.GetService().(terraformutils.ServiceWithImportedResources); ok {
+		serviceWithImportedResources.SetImportedResources(importedResources)
+	}
 	provider.GetService().ParseFilters(options.Filter)
 	err = provider.GetService().InitResources()
 	if err != nil {
@@ -224,6 +314,13 @@ func ImportFromPlan(provider terraformutils.ProviderGenerator, plan *ImportPlan)
 	importedResource := plan.ImportedResource
 	isServicePath := strings.Contains(options.PathPattern, "{service}")
 
+	if options.Output == "inventory" {
+		path := filepath.Clean(Path(options.PathPattern, provider.GetName(), "", options.PathOutput))
+		log.Println(provider.GetName() + " save inventory to " + path)
+		inventory := terraformoutput.NewInventory(provider.GetName(), importedResource, provider.GetResourceConnections())
+		return terraformoutput.OutputInventory(inventory, path, options.InventoryFormat)
+	}
+
 	if options.Connect {
 		log.Println(provider.GetName() + " Connecting.... ")
 		importedResource = terraformutils.ConnectServices(importedResource, isServicePath, provider.GetResourceConnections())
@@ -360,6 +457,34 @@ func printService(provider terraformutils.ProviderGenerator, serviceName string,
 	return nil
 }
 
+func validateImport(provider terraformutils.ProviderGenerator, options ImportOptions, importedResource map[string][]terraformutils.Resource, providerWrapper *providerwrapper.ProviderWrapper) error {
+	var paths []string
+	if strings.Contains(options.PathPattern, "{service}") {
+		for serviceName := range importedResource {
+			paths = append(paths, Path(options.PathPattern, provider.GetName(), serviceName, options.PathOutput))
+		}
+		sort.Strings(paths)
+	} else {
+		paths = append(paths, Path(options.PathPattern, provider.GetName(), "", options.PathOutput))
+	}
+	problemsCount := 0
+	for _, path := range paths {
+		log.Println(provider.GetName() + " validate " + path)
+		problems, err := terraformoutput.ValidateHclFiles(path, providerWrapper)
+		if err != nil {
+			return err
+		}
+		for _, problem := range problems {
+			log.Printf("[ERR]: %s", problem)
+		}
+		problemsCount += len(problems)
+	}
+	if problemsCount > 0 {
+		return fmt.Errorf("%s: validation found %d problem(s) in generated files", provider.GetName(), problemsCount)
+	}
+	return nil
+}
+
 func Path(pathPattern, providerName, serviceName, output string) string {
 	return strings.NewReplacer(
 		"{provider}", providerName,
@@ -406,7 +531,15 @@ func baseProviderFlags(flag *pflag.FlagSet, options *ImportOptions, sampleRes, s
 	flag.StringSliceVarP(&options.Filter, "filter", "f", []string{}, sampleFilters)
 	flag.BoolVarP(&options.Verbose, "verbose", "v", false, "")
 	flag.BoolVarP(&options.NoSort, "no-sort", "S", false, "set to disable sorting of HCL")
-	flag.StringVarP(&options.Output, "output", "O", "hcl", "output format hcl or json")
+	flag.StringVarP(&options.Output, "output", "O", "hcl", "output format hcl, json or inventory")
 	flag.IntVarP(&options.RetryCount, "retry-number", "n", 5, "number of retries to perform when refresh fails")
 	flag.IntVarP(&options.RetrySleepMs, "retry-sleep-ms", "m", 300, "time in ms to sleep between retries")
+	flag.BoolVar(&options.Validate, "validate", false, "validate generated files against the provider schema")
+	flag.StringVar(&options.Transform, "transform-rules", "", "YAML file with attribute transformation rules")
+	flag.BoolVar(&options.RedactSecrets, "redact-secrets", false, "replace secrets in generated files with sensitive variables")
+	flag.BoolVar(&options.StripSecrets, "strip-secrets", false, "remove secret values from the generated state")
+	flag.BoolVar(&options.IgnoreChanges, "ignore-changes", false, "add lifecycle ignore_changes for attributes managed outside Terraform")
+	flag.StringVar(&options.LifecycleConfig, "lifecycle-config", "", "YAML file with ignore_changes and prevent_destroy settings")
+	flag.StringVar(&options.InventoryFormat, "inventory-format", terraformoutput.InventoryJSON, "format of --output inventory: json, ndjson or yaml")
+	flag.BoolVar(&options.PreventDestroyLocked, "prevent-destroy-locked", false, "add lifecycle prevent_destroy to resources protected by a lock")
 }
@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/providerwrapper"
 	"github.com/spf13/cobra"
 )
 
@@ -80,7 +81,19 @@ func newCmdPlanImporter(options ImportOptions) *cobra.Command {
 				}
 			}
 
-			return ImportFromPlan(provider, plan)
+			if err = ImportFromPlan(provider, plan); err != nil {
+				return err
+			}
+
+			if plan.Options.Validate && plan.Options.Output != "inventory" {
+				providerWrapper, err := providerwrapper.NewProviderWrapper(provider.GetName(), provider.GetConfig(), plan.Options.Verbose)
+				if err != nil {
+					return err
+				}
+				defer providerWrapper.Kill()
+				return validateImport(provider, plan.Options, plan.ImportedResource, providerWrapper)
+			}
+			return nil
 		},
 	}
 	return cmd
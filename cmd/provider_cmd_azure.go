@@ -27,7 +27,7 @@ func newCmdAzureImporter(options ImportOptions) *cobra.Command {
 		Long:  "Import current state to Terraform configuration from Azure",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			provider := newAzureProvider()
-			err := Import(provider, options, []string{options.ResourceGroup})
+			err := Import(provider, options, []string{options.ResourceGroup, options.ManagementGroup})
 			if err != nil {
 				return err
 			}
@@ -38,6 +38,7 @@ func newCmdAzureImporter(options ImportOptions) *cobra.Command {
 	cmd.AddCommand(listCmd(newAzureProvider()))
 	baseProviderFlags(cmd.PersistentFlags(), &options, "resource_group", "resource_group=name1:name2:name3")
 	cmd.PersistentFlags().StringVarP(&options.ResourceGroup, "resource-group", "R", "", "")
+	cmd.PersistentFlags().StringVarP(&options.ManagementGroup, "management-group", "M", "", "management group to import governance resources from")
 	return cmd
 }
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-03-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/kubernetesconfiguration/mgmt/2022-03-01/kubernetesconfiguration"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/hashicorp/go-azure-helpers/authentication"
@@ -15,6 +16,77 @@ type AKSGenerator struct {
 	AzureService
 }
 
+// listClusterExtensions lists the extensions and Flux configurations installed on the cluster
+func (g AKSGenerator) listClusterExtensions(ctx context.Context, resourceGroup string, clusterName string, resourceName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
+	extensionsClient := kubernetesconfiguration.NewExtensionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	extensionsClient.Authorizer = authorizer
+	fluxClient := kubernetesconfiguration.NewFluxConfigurationsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	fluxClient.Authorizer = authorizer
+
+	extensionIterator, err := extensionsClient.ListComplete(ctx, resourceGroup, "Microsoft.ContainerService", "managedClusters", clusterName)
+	if err != nil {
+		return resources, err
+	}
+	for extensionIterator.NotDone() {
+		extension := extensionIterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*extension.ID,
+			resourceName+"_"+*extension.Name,
+			"azurerm_kubernetes_cluster_extension",
+			g.ProviderName,
+			[]string{}))
+		if err := extensionIterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+
+	fluxIterator, err := fluxClient.ListComplete(ctx, resourceGroup, "Microsoft.ContainerService", "managedClusters", clusterName)
+	if err != nil {
+		return resources, err
+	}
+	for fluxIterator.NotDone() {
+		configuration := fluxIterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*configuration.ID,
+			resourceName+"_"+*configuration.Name,
+			"azurerm_kubernetes_flux_configuration",
+			g.ProviderName,
+			[]string{}))
+		if err := fluxIterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+// listFleetManagers lists the fleet managers, the vendored SDK has no client for them
+func (g AKSGenerator) listFleetManagers() ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	for _, rgName := range g.resourceGroups() {
+		path := "/subscriptions/{subscriptionId}/providers/Microsoft.ContainerService/fleets"
+		if rgName != "" {
+			path = "/subscriptions/{subscriptionId}/resourceGroups/" + rgName + "/providers/Microsoft.ContainerService/fleets"
+		}
+		fleets, err := g.listARMResources(path, "2023-10-15")
+		if err != nil {
+			return resources, err
+		}
+		for _, fleet := range fleets {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				fleet.ID,
+				fleet.Name,
+				"azurerm_kubernetes_fleet_manager",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+	return resources, nil
+}
+
 func (g AKSGenerator) createResources(ctx context.Context, iterator containerservice.ManagedClusterListResultIterator) ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	for iterator.NotDone() {
@@ -23,10 +95,9 @@ func (g AKSGenerator) createResources(ctx context.Context, iterator containerser
 
 		iObj, _ := ParseAzureResourceID(*cluster.ID)
 		resourceGroup := iObj.ResourceGroup
-		aksID := fixRGChars(*cluster.ID)
 		// 添加 AKS 集群资源
 		resources = append(resources, terraformutils.NewSimpleResource(
-			aksID,
+			*cluster.ID,
 			resourceGroup+"_"+tferName,
 			"azurerm_kubernetes_cluster",
 			g.ProviderName,
@@ -45,15 +116,8 @@ func (g AKSGenerator) createResources(ctx context.Context, iterator containerser
 		for agentPoolIterator.NotDone() {
 			agentPool := agentPoolIterator.Value()
 
-			// 使用正确的资源 ID 格式
-			agentPoolID := fixRGChars(*agentPool.ID)
-
-			// 检查并修正资源 ID 中的大小写
-			// agentPoolID = fixRGChars(agentPoolID)
-			// log.Println("agentPoolID: ", agentPoolID)
-
 			resources = append(resources, terraformutils.NewSimpleResource(
-				agentPoolID,
+				*agentPool.ID,
 				resourceGroup+"_"+tferName+"_"+*agentPool.Name,
 				"azurerm_kubernetes_cluster_node_pool",
 				g.ProviderName,
@@ -64,6 +128,13 @@ func (g AKSGenerator) createResources(ctx context.Context, iterator containerser
 			}
 		}
 
+		// the cluster is imported even if its extensions can't be listed
+		extensions, err := g.listClusterExtensions(ctx, resourceGroup, *cluster.Name, resourceGroup+"_"+tferName)
+		if err != nil {
+			log.Printf("can't list the extensions of cluster %s: %v", *cluster.Name, err)
+		}
+		resources = append(resources, extensions...)
+
 		if err := iterator.NextWithContext(ctx); err != nil {
 			log.Println(err)
 			return resources, err
@@ -72,14 +143,6 @@ func (g AKSGenerator) createResources(ctx context.Context, iterator containerser
 	return resources, nil
 }
 
-// 新增一个函数来修正资源 ID 的大小写
-func fixRGChars(id string) string {
-	// 将 resourcegroups 替换为 resourceGroups
-	id = strings.Replace(id, "/resourcegroups/", "/resourceGroups/", -1)
-	// 其他必要的替换
-	return id
-}
-
 func (g *AKSGenerator) InitResources() error {
 	ctx := context.Background()
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
@@ -113,8 +176,22 @@ func (g *AKSGenerator) InitResources() error {
 			return err
 		}
 		g.Resources, err = g.createResources(ctx, iterator)
-		return err
+		if err != nil {
+			return err
+		}
+	}
+
+	// fleets are skipped when their API version isn't registered or can't be accessed
+	fleets, err := g.listFleetManagers()
+	if err != nil {
+		log.Printf("can't list fleet managers: %v", err)
 	}
+	g.Resources = append(g.Resources, fleets...)
 	return nil
+}
 
+// PostConvertHook links node pools, extensions and Flux configurations to their cluster
+func (g *AKSGenerator) PostConvertHook() error {
+	g.linkResourceIDs("kubernetes_cluster_id", "cluster_id")
+	return nil
 }
@@ -0,0 +1,461 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/secrets"
+)
+
+// definitionDownloadTimeout bounds the download of an exported API definition
+const definitionDownloadTimeout = 2 * time.Minute
+
+type APIManagementGenerator struct {
+	AzureService
+	// definitions holds the OpenAPI definitions exported from the APIs, by API ID
+	definitions map[string][]byte
+}
+
+// exportDefinition downloads the OpenAPI definition of the API, which Azure
+// exports to a blob only readable for a few minutes
+func (az *APIManagementGenerator) exportDefinition(resourceGroup, serviceName, apiName string) ([]byte, error) {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewAPIExportClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	export, err := client.Get(ctx, resourceGroup, serviceName, apiName, apimanagement.ExportFormatOpenapiJSON)
+	if err != nil {
+		return nil, err
+	}
+	if export.Value == nil || export.Value.Link == nil {
+		return nil, fmt.Errorf("no definition exported for API %s of %s", apiName, serviceName)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, *export.Value.Link, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpClient := &http.Client{Timeout: definitionDownloadTimeout}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download the definition of API %s of %s: %s", apiName, serviceName, response.Status)
+	}
+	return io.ReadAll(response.Body)
+}
+
+func (az *APIManagementGenerator) appendOperations(resourceGroup, serviceName, apiName, parentName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewAPIOperationClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	policyClient := apimanagement.NewAPIOperationPolicyClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	policyClient.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByAPIComplete(ctx, resourceGroup, serviceName, apiName, "", nil, nil, "")
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		operation := iterator.Value()
+		operationName := parentName + "_" + *operation.Name
+		az.AppendSimpleResource(*operation.ID, operationName, "azurerm_api_management_api_operation")
+		policies, err := policyClient.ListByOperation(ctx, resourceGroup, serviceName, apiName, *operation.Name)
+		if err != nil {
+			return err
+		}
+		if policies.Value != nil {
+			for _, policy := range *policies.Value {
+				az.AppendSimpleResource(*policy.ID, operationName+"_"+*policy.Name, "azurerm_api_management_api_operation_policy")
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) appendAPIDiagnostics(resourceGroup, serviceName, apiName, parentName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewAPIDiagnosticClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByServiceComplete(ctx, resourceGroup, serviceName, apiName, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, parentName+"_"+*item.Name, "azurerm_api_management_api_diagnostic")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) appendAPIs(resourceGroup, serviceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewAPIClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	policyClient := apimanagement.NewAPIPolicyClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	policyClient.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByServiceComplete(ctx, resourceGroup, serviceName, "", nil, nil, "", nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		api := iterator.Value()
+		// older revisions are only reachable through the current one
+		if api.APIContractProperties != nil && api.IsCurrent != nil && !*api.IsCurrent {
+			if err := iterator.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return err
+			}
+			continue
+		}
+		apiName := serviceName + "_" + *api.Name
+		az.AppendSimpleResource(*api.ID, apiName, "azurerm_api_management_api")
+		if api.APIContractProperties != nil && api.APIType == apimanagement.APITypeHTTP {
+			definition, err := az.exportDefinition(resourceGroup, serviceName, *api.Name)
+			if err != nil {
+				log.Println(err)
+			} else {
+				az.definitions[*api.ID] = definition
+			}
+		}
+		if err := az.appendOperations(resourceGroup, serviceName, *api.Name, apiName); err != nil {
+			return err
+		}
+		policies, err := policyClient.ListByAPI(ctx, resourceGroup, serviceName, *api.Name)
+		if err != nil {
+			return err
+		}
+		if policies.Value != nil {
+			for _, policy := range *policies.Value {
+				az.AppendSimpleResource(*policy.ID, apiName+"_"+*policy.Name, "azurerm_api_management_api_policy")
+			}
+		}
+		if err := az.appendAPIDiagnostics(resourceGroup, serviceName, *api.Name, apiName); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) appendProducts(resourceGroup, serviceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewProductClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	apisClient := apimanagement.NewProductAPIClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	apisClient.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByServiceComplete(ctx, resourceGroup, serviceName, "", nil, nil, nil, "")
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		product := iterator.Value()
+		productName := serviceName + "_" + *product.Name
+		az.AppendSimpleResource(*product.ID, productName, "azurerm_api_management_product")
+		apis, err := apisClient.ListByProductComplete(ctx, resourceGroup, serviceName, *product.Name, "", nil, nil)
+		if err != nil {
+			return err
+		}
+		for apis.NotDone() {
+			api := apis.Value()
+			// the API is listed with its own ID, the link is addressed below the product
+			az.AppendSimpleResource(*product.ID+"/apis/"+*api.Name, productName+"_"+*api.Name, "azurerm_api_management_product_api")
+			if err := apis.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return err
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) appendNamedValues(resourceGroup, serviceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewNamedValueClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByServiceComplete(ctx, resourceGroup, serviceName, "", nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, serviceName+"_"+*item.Name, "azurerm_api_management_named_value")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) appendBackends(resourceGroup, serviceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewBackendClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByServiceComplete(ctx, resourceGroup, serviceName, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, serviceName+"_"+*item.Name, "azurerm_api_management_backend")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) appendLoggers(resourceGroup, serviceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewLoggerClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByServiceComplete(ctx, resourceGroup, serviceName, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		// the Azure Monitor logger is created by Azure and can't be managed
+		if item.LoggerContractProperties == nil || item.LoggerType != apimanagement.LoggerTypeAzureMonitor {
+			az.AppendSimpleResource(*item.ID, serviceName+"_"+*item.Name, "azurerm_api_management_logger")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) appendDiagnostics(resourceGroup, serviceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewDiagnosticClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByServiceComplete(ctx, resourceGroup, serviceName, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, serviceName+"_"+*item.Name, "azurerm_api_management_diagnostic")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) appendSubscriptions(resourceGroup, serviceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewSubscriptionClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, resourceGroup, serviceName, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		// the built-in all-access subscription can't be managed
+		if *item.Name != "master" {
+			az.AppendSimpleResource(*item.ID, serviceName+"_"+*item.Name, "azurerm_api_management_subscription")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) appendGroups(resourceGroup, serviceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewGroupClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByServiceComplete(ctx, resourceGroup, serviceName, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		// administrators, developers and guests are system groups
+		if item.GroupContractProperties == nil || item.GroupContractProperties.Type != apimanagement.GroupTypeSystem {
+			az.AppendSimpleResource(*item.ID, serviceName+"_"+*item.Name, "azurerm_api_management_group")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// hasCustomDomain tells if one of the host names of the service isn't an azure-api.net default
+func hasCustomDomain(service apimanagement.ServiceResource) bool {
+	if service.ServiceProperties == nil || service.HostnameConfigurations == nil {
+		return false
+	}
+	for _, hostname := range *service.HostnameConfigurations {
+		if hostname.HostName != nil && !strings.HasSuffix(strings.ToLower(*hostname.HostName), ".azure-api.net") {
+			return true
+		}
+	}
+	return false
+}
+
+func (az *APIManagementGenerator) appendServiceContents(resourceGroup, serviceName string) error {
+	appendFuncs := []func(string, string) error{
+		az.appendAPIs,
+		az.appendProducts,
+		az.appendNamedValues,
+		az.appendBackends,
+		az.appendLoggers,
+		az.appendDiagnostics,
+		az.appendSubscriptions,
+		az.appendGroups,
+	}
+	for _, appendFunc := range appendFuncs {
+		if err := appendFunc(resourceGroup, serviceName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) appendServices(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := apimanagement.NewServiceClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator apimanagement.ServiceListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListComplete(ctx)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		service := iterator.Value()
+		az.AppendSimpleResource(*service.ID, *service.Name, "azurerm_api_management")
+		if hasCustomDomain(service) {
+			az.AppendSimpleResource(*service.ID+"/customDomains/default", *service.Name, "azurerm_api_management_custom_domain")
+		}
+		id, err := ParseAzureResourceID(*service.ID)
+		if err != nil {
+			return err
+		}
+		if err := az.appendServiceContents(id.ResourceGroup, *service.Name); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *APIManagementGenerator) InitResources() error {
+	az.definitions = map[string][]byte{}
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendServices(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook writes policies and API definitions to files, replaces secret named
+// values with variables and leaves out what is imported as separate resources
+func (az *APIManagementGenerator) PostConvertHook() error {
+	customDomains := map[string]bool{}
+	for _, r := range az.Resources {
+		if r.InstanceInfo.Type == "azurerm_api_management_custom_domain" {
+			customDomains[strings.ToLower(r.InstanceState.Attributes["api_management_id"])] = true
+		}
+	}
+	for i, r := range az.Resources {
+		resource := &az.Resources[i]
+		switch r.InstanceInfo.Type {
+		case "azurerm_api_management":
+			if customDomains[strings.ToLower(r.InstanceState.ID)] {
+				delete(resource.Item, "hostname_configuration")
+			}
+		case "azurerm_api_management_api":
+			if definition, ok := az.definitions[r.InstanceState.ID]; ok {
+				resource.Item["import"] = []interface{}{map[string]interface{}{
+					"content_format": "openapi+json",
+					"content_value":  writeDataFile(resource, r.ResourceName+".json", definition),
+				}}
+			}
+		case "azurerm_api_management_api_policy", "azurerm_api_management_api_operation_policy":
+			if content := r.InstanceState.Attributes["xml_content"]; content != "" {
+				resource.Item["xml_content"] = writeDataFile(resource, r.ResourceName+".xml", []byte(content))
+			}
+		case "azurerm_api_management_named_value":
+			if r.InstanceState.Attributes["secret"] == "true" {
+				resource.Item["value"] = secrets.AddVariable(resource, []string{"value"})
+				resource.InstanceState.Attributes["value"] = ""
+			}
+		case "azurerm_api_management_subscription":
+			// the keys are generated by Azure when left out
+			delete(resource.Item, "primary_key")
+			delete(resource.Item, "secondary_key")
+		}
+	}
+	az.linkResourceIDs("api_management_id", "api_management_logger_id")
+	return nil
+}
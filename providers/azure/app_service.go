@@ -2,64 +2,169 @@ package azure
 
 import (
 	"context"
+	"fmt"
 	"log"
-
-	"github.com/Azure/go-autorest/autorest"
-	"github.com/hashicorp/go-azure-helpers/authentication"
+	"net/http"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2019-08-01/web"
-	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 )
 
 type AppServiceGenerator struct {
 	AzureService
 }
 
-func (g AppServiceGenerator) listApps() ([]terraformutils.Resource, error) {
-	var resources []terraformutils.Resource
-	ctx := context.Background()
+// appResourceType returns the resource type of a web or function app by its kind and OS,
+// kind is a comma separated list like "app,linux,container" or "functionapp"
+func appResourceType(site web.Site) string {
+	kind := ""
+	if site.Kind != nil {
+		kind = strings.ToLower(*site.Kind)
+	}
+	osType := "windows"
+	if strings.Contains(kind, "linux") || (site.SiteProperties != nil && site.SiteProperties.Reserved != nil && *site.SiteProperties.Reserved) {
+		osType = "linux"
+	}
+	if strings.Contains(kind, "functionapp") {
+		return fmt.Sprintf("azurerm_%s_function_app", osType)
+	}
+	return fmt.Sprintf("azurerm_%s_web_app", osType)
+}
 
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+// isWorkflowApp tells if the site is a Logic App Standard, imported by the logic_app service
+func isWorkflowApp(site web.Site) bool {
+	return site.Kind != nil && strings.Contains(strings.ToLower(*site.Kind), "workflowapp")
+}
+
+func (az *AzureService) listApps() ([]web.Site, error) {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
 	appServiceClient := web.NewAppsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
-	appServiceClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
-	var (
-		appsIterator web.AppCollectionIterator
-		err          error
-	)
-	if rg := g.Args["resource_group"].(string); rg != "" {
-		appsIterator, err = appServiceClient.ListByResourceGroupComplete(ctx, rg, nil)
-	} else {
-		appsIterator, err = appServiceClient.ListComplete(ctx)
+	appServiceClient.Authorizer = authorizer
+	ctx := context.Background()
+	var sites []web.Site
+	for _, rgName := range az.resourceGroups() {
+		var (
+			appsIterator web.AppCollectionIterator
+			err          error
+		)
+		if rgName != "" {
+			appsIterator, err = appServiceClient.ListByResourceGroupComplete(ctx, rgName, nil)
+		} else {
+			appsIterator, err = appServiceClient.ListComplete(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for appsIterator.NotDone() {
+			sites = append(sites, appsIterator.Value())
+			if err := appsIterator.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return sites, err
+			}
+		}
 	}
+	return sites, nil
+}
+
+func (g *AppServiceGenerator) appendSlots(client web.AppsClient, site web.Site, resourceGroup string) error {
+	ctx := context.Background()
+	iterator, err := client.ListSlotsComplete(ctx, resourceGroup, *site.Name)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	for iterator.NotDone() {
+		slot := iterator.Value()
+		if slot.Kind == nil {
+			slot.Kind = site.Kind
+		}
+		g.AppendSimpleResource(*slot.ID, *slot.Name, appResourceType(slot)+"_slot")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
 	}
-	for appsIterator.NotDone() {
-		site := appsIterator.Value()
-		resources = append(resources, terraformutils.NewSimpleResource(
-			*site.ID,
-			*site.Name,
-			"azurerm_app_service",
-			g.ProviderName,
-			[]string{}))
+	return nil
+}
 
-		if err := appsIterator.NextWithContext(ctx); err != nil {
+func (g *AppServiceGenerator) appendHostNameBindings(client web.AppsClient, site web.Site, resourceGroup string) error {
+	ctx := context.Background()
+	iterator, err := client.ListHostNameBindingsComplete(ctx, resourceGroup, *site.Name)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		binding := iterator.Value()
+		// the default *.azurewebsites.net binding is managed by Azure
+		if !strings.HasSuffix(strings.ToLower(*binding.Name), ".azurewebsites.net") {
+			g.AppendSimpleResource(*binding.ID, *binding.Name, "azurerm_app_service_custom_hostname_binding")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
 			log.Println(err)
-			return resources, err
+			return err
 		}
 	}
+	return nil
+}
 
-	return resources, nil
+func (g *AppServiceGenerator) appendSwiftConnection(client web.AppsClient, site web.Site, resourceGroup string) error {
+	connection, err := client.GetSwiftVirtualNetworkConnection(context.Background(), resourceGroup, *site.Name)
+	if err != nil {
+		if connection.Response.Response != nil && connection.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+	if connection.SwiftVirtualNetworkProperties == nil || connection.SubnetResourceID == nil || *connection.SubnetResourceID == "" {
+		return nil
+	}
+	g.AppendSimpleResource(*site.ID+"/config/virtualNetwork", *site.Name, "azurerm_app_service_virtual_network_swift_connection")
+	return nil
 }
 
 func (g *AppServiceGenerator) InitResources() error {
-	resources, err := g.listApps()
+	sites, err := g.listApps()
 	if err != nil {
 		return err
 	}
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
+	client := web.NewAppsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	for _, site := range sites {
+		if isWorkflowApp(site) {
+			continue
+		}
+		g.AppendSimpleResource(*site.ID, *site.Name, appResourceType(site))
+		id, err := ParseAzureResourceID(*site.ID)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if err := g.appendSlots(client, site, id.ResourceGroup); err != nil {
+			log.Println(err)
+		}
+		if err := g.appendHostNameBindings(client, site, id.ResourceGroup); err != nil {
+			log.Println(err)
+		}
+		if err := g.appendSwiftConnection(client, site, id.ResourceGroup); err != nil {
+			log.Println(err)
+		}
+	}
+	return nil
+}
 
-	g.Resources = append(g.Resources, resources...)
-
+// PostConvertHook links slots, hostname bindings and VNet integrations to their app
+func (g *AppServiceGenerator) PostConvertHook() error {
+	for _, app := range g.Resources {
+		if !strings.HasSuffix(app.InstanceInfo.Type, "_web_app") && !strings.HasSuffix(app.InstanceInfo.Type, "_function_app") {
+			continue
+		}
+		for i, r := range g.Resources {
+			value, ok := r.Item["app_service_name"].(string)
+			if ok && value == app.Item["name"] && r.Item["resource_group_name"] == app.Item["resource_group_name"] {
+				g.Resources[i].Item["app_service_name"] = fmt.Sprintf("${%s.%s}", app.InstanceInfo.Id, "name")
+			}
+		}
+	}
+	g.linkResourceIDs("app_service_id", "function_app_id")
 	return nil
 }
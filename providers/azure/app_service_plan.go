@@ -0,0 +1,67 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2019-08-01/web"
+)
+
+type AppServicePlanGenerator struct {
+	AzureService
+}
+
+func (az *AppServicePlanGenerator) listResources() ([]web.AppServicePlan, error) {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := web.NewAppServicePlansClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	var resources []web.AppServicePlan
+	for _, rgName := range az.resourceGroups() {
+		var (
+			iterator web.AppServicePlanCollectionIterator
+			err      error
+		)
+		if rgName != "" {
+			iterator, err = client.ListByResourceGroupComplete(ctx, rgName)
+		} else {
+			iterator, err = client.ListComplete(ctx, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for iterator.NotDone() {
+			resources = append(resources, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return resources, err
+			}
+		}
+	}
+	return resources, nil
+}
+
+func (az *AppServicePlanGenerator) InitResources() error {
+	resources, err := az.listResources()
+	if err != nil {
+		return err
+	}
+	for _, resource := range resources {
+		az.AppendSimpleResource(*resource.ID, *resource.Name, "azurerm_service_plan")
+	}
+	return nil
+}
@@ -68,5 +68,57 @@ func (g *ApplicationGatewayGenerator) InitResources() error {
 		return err
 	}
 	g.Resources, err = g.createResources(ctx, output)
-	return err
+	if err != nil {
+		return err
+	}
+
+	policies, err := g.createWebApplicationFirewallPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	g.Resources = append(g.Resources, policies...)
+	return nil
+}
+
+func (g ApplicationGatewayGenerator) createWebApplicationFirewallPolicies(ctx context.Context) ([]terraformutils.Resource, error) {
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+	policiesClient := network.NewWebApplicationFirewallPoliciesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+
+	policiesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+
+	var (
+		iterator network.WebApplicationFirewallPolicyListResultIterator
+		err      error
+	)
+
+	if rg := g.Args["resource_group"].(string); rg != "" {
+		iterator, err = policiesClient.ListComplete(ctx, rg)
+	} else {
+		iterator, err = policiesClient.ListAllComplete(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var resources []terraformutils.Resource
+	for iterator.NotDone() {
+		policy := iterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*policy.ID,
+			*policy.Name,
+			"azurerm_web_application_firewall_policy",
+			g.ProviderName,
+			[]string{}))
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+// PostConvertHook links the gateways to their imported firewall policy
+func (g *ApplicationGatewayGenerator) PostConvertHook() error {
+	g.linkResourceIDs("firewall_policy_id")
+	return nil
 }
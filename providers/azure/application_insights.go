@@ -0,0 +1,65 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
+)
+
+type ApplicationInsightsGenerator struct {
+	AzureService
+}
+
+func (az *ApplicationInsightsGenerator) appendComponents(iterator insights.ApplicationInsightsComponentListResultIterator) error {
+	ctx := context.Background()
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, *item.Name, "azurerm_application_insights")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *ApplicationInsightsGenerator) InitResources() error {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := insights.NewComponentsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	if resourceGroup == "" {
+		iterator, err := client.ListComplete(ctx)
+		if err != nil {
+			return err
+		}
+		return az.appendComponents(iterator)
+	}
+	for _, rgName := range strings.Split(resourceGroup, ",") {
+		iterator, err := client.ListByResourceGroupComplete(ctx, strings.TrimSpace(rgName))
+		if err != nil {
+			return err
+		}
+		if err := az.appendComponents(iterator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
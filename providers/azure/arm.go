@@ -0,0 +1,77 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// armResource is the part of an ARM resource common to all resource providers
+type armResource struct {
+	ID         string                 `json:"id"`
+	Name       string                 `json:"name"`
+	Type       string                 `json:"type"`
+	Kind       string                 `json:"kind"`
+	Location   string                 `json:"location"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type armResourceList struct {
+	Value    []armResource `json:"value"`
+	NextLink string        `json:"nextLink"`
+}
+
+// listARMResources lists the resources at an ARM path, following nextLink.
+// It's used for resource types the vendored SDK has no client for.
+func (az *AzureService) listARMResources(path string, apiVersion string) ([]armResource, error) {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := autorest.NewClientWithUserAgent("terraformer")
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsGet(),
+		autorest.WithBaseURL(resourceManagerEndpoint),
+		autorest.WithPathParameters(path, map[string]interface{}{"subscriptionId": subscriptionID}),
+		autorest.WithQueryParameters(map[string]interface{}{"api-version": apiVersion}))
+	var resources []armResource
+	for err == nil {
+		var resp *http.Response
+		resp, err = autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client))
+		if err != nil {
+			return resources, err
+		}
+		var page armResourceList
+		err = autorest.Respond(resp,
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			return resources, err
+		}
+		resources = append(resources, page.Value...)
+		if page.NextLink == "" {
+			return resources, nil
+		}
+		req, err = autorest.Prepare((&http.Request{}).WithContext(ctx),
+			autorest.AsGet(),
+			autorest.WithBaseURL(page.NextLink))
+	}
+	return resources, err
+}
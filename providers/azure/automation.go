@@ -0,0 +1,251 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2019-06-01/automation"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/secrets"
+)
+
+type AutomationGenerator struct {
+	AzureService
+}
+
+// runbookFileExtensions maps the runbook types to the extension of the file holding their content
+var runbookFileExtensions = map[string]string{
+	"PowerShell":         ".ps1",
+	"PowerShell72":       ".ps1",
+	"PowerShellWorkflow": ".ps1",
+	"Python":             ".py",
+	"Python2":            ".py",
+	"Python3":            ".py",
+	"GraphPowerShell":    ".graphrunbook",
+	"Script":             ".txt",
+}
+
+// automationVariableType returns the resource type of the variable by its JSON encoded value,
+// encrypted values aren't returned and are taken as strings
+func automationVariableType(value *string) string {
+	if value == nil || *value == "" {
+		return "azurerm_automation_variable_string"
+	}
+	switch v := *value; {
+	case v == "true" || v == "false":
+		return "azurerm_automation_variable_bool"
+	case strings.HasPrefix(v, `"\/Date(`):
+		return "azurerm_automation_variable_datetime"
+	case strings.HasPrefix(v, `"`) || v == "null":
+		return "azurerm_automation_variable_string"
+	case strings.HasPrefix(v, "{") || strings.HasPrefix(v, "["):
+		return "azurerm_automation_variable_object"
+	default:
+		return "azurerm_automation_variable_int"
+	}
+}
+
+func (az *AutomationGenerator) appendRunbooks(resourceGroup, accountName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := automation.NewRunbookClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, accountName+"_"+*item.Name, "azurerm_automation_runbook")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *AutomationGenerator) appendSchedules(resourceGroup, accountName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := automation.NewScheduleClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, accountName+"_"+*item.Name, "azurerm_automation_schedule")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *AutomationGenerator) appendJobSchedules(resourceGroup, accountName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := automation.NewJobScheduleClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName, "")
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		resourceName := accountName + "_" + *item.Name
+		if item.JobScheduleProperties != nil && item.Runbook != nil && item.Schedule != nil &&
+			item.Runbook.Name != nil && item.Schedule.Name != nil {
+			resourceName = accountName + "_" + *item.Runbook.Name + "_" + *item.Schedule.Name
+		}
+		az.AppendSimpleResource(*item.ID, resourceName, "azurerm_automation_job_schedule")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *AutomationGenerator) appendVariables(resourceGroup, accountName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := automation.NewVariableClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		var value *string
+		if item.VariableProperties != nil {
+			value = item.Value
+		}
+		az.AppendSimpleResource(*item.ID, accountName+"_"+*item.Name, automationVariableType(value))
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *AutomationGenerator) appendModules(resourceGroup, accountName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := automation.NewModuleClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		// global modules are provided by Azure
+		if item.ModuleProperties == nil || item.IsGlobal == nil || !*item.IsGlobal {
+			az.AppendSimpleResource(*item.ID, accountName+"_"+*item.Name, "azurerm_automation_module")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *AutomationGenerator) appendAccounts(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := automation.NewAccountClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator automation.AccountListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListComplete(ctx)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		account := iterator.Value()
+		az.AppendSimpleResource(*account.ID, *account.Name, "azurerm_automation_account")
+		id, err := ParseAzureResourceID(*account.ID)
+		if err != nil {
+			return err
+		}
+		appendFuncs := []func(string, string) error{
+			az.appendRunbooks,
+			az.appendSchedules,
+			az.appendJobSchedules,
+			az.appendVariables,
+			az.appendModules,
+		}
+		for _, appendFunc := range appendFuncs {
+			if err := appendFunc(id.ResourceGroup, *account.Name); err != nil {
+				return err
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *AutomationGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendAccounts(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook writes runbook contents to files and replaces encrypted variable values,
+// which Azure doesn't return, with variables
+func (az *AutomationGenerator) PostConvertHook() error {
+	for i, r := range az.Resources {
+		switch {
+		case r.InstanceInfo.Type == "azurerm_automation_runbook":
+			content := r.InstanceState.Attributes["content"]
+			if content == "" {
+				continue
+			}
+			extension, ok := runbookFileExtensions[r.InstanceState.Attributes["runbook_type"]]
+			if !ok {
+				extension = ".txt"
+			}
+			az.Resources[i].Item["content"] = writeDataFile(&az.Resources[i], r.ResourceName+extension, []byte(content))
+		case strings.HasPrefix(r.InstanceInfo.Type, "azurerm_automation_variable_"):
+			if r.InstanceState.Attributes["encrypted"] == "true" {
+				az.Resources[i].Item["value"] = secrets.AddVariable(&az.Resources[i], []string{"value"})
+				az.Resources[i].InstanceState.Attributes["value"] = ""
+			}
+		}
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ package azure
 
 import (
 	"context"
+	_ "embed"
 	"errors"
 	"fmt"
 	"os"
@@ -30,11 +31,16 @@ import (
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils/providerwrapper"
 )
 
+//go:embed transform_rules.yaml
+var transformRules []byte
+
 type AzureProvider struct { //nolint
 	terraformutils.Provider
-	config        authentication.Config
-	authorizer    autorest.Authorizer
-	resourceGroup string
+	config             authentication.Config
+	authorizer         autorest.Authorizer
+	keyVaultAuthorizer autorest.Authorizer
+	resourceGroup      string
+	managementGroup    string
 }
 
 func (p *AzureProvider) setEnvConfig() error {
@@ -107,9 +113,11 @@ func (p *AzureProvider) getAuthorizer() (autorest.Authorizer, error) {
 			return nil, ero
 		}
 		auth, err = p.config.GetMSALToken(ctx, hamiltonEnv.ResourceManager, sender, oauthConfig, env.TokenAudience)
+		p.keyVaultAuthorizer = p.config.MSALBearerAuthorizerCallback(ctx, hamiltonEnv.KeyVault, sender, oauthConfig, env.ResourceIdentifiers.KeyVault)
 	} else {
 		// Deprecated
 		auth, err = p.config.GetADALToken(ctx, sender, oauthConfig, env.ResourceManagerEndpoint)
+		p.keyVaultAuthorizer = p.config.ADALBearerAuthorizerCallback(ctx, sender, oauthConfig)
 	}
 	if err != nil {
 		return nil, err
@@ -129,6 +137,9 @@ func (p *AzureProvider) Init(args []string) error {
 	}
 	p.authorizer = authorizer
 	p.resourceGroup = args[0]
+	if len(args) > 1 {
+		p.managementGroup = args[1]
+	}
 
 	return nil
 }
@@ -137,6 +148,10 @@ func (p *AzureProvider) GetName() string {
 	return "azurerm"
 }
 
+func (p *AzureProvider) GetTransformRules() []byte {
+	return transformRules
+}
+
 func (p *AzureProvider) GetProviderData(arg ...string) map[string]interface{} {
 	version := providerwrapper.GetProviderVersion(p.GetName())
 	if strings.Contains(version, "v2.") {
@@ -162,16 +177,95 @@ func (p *AzureProvider) GetProviderData(arg ...string) map[string]interface{} {
 	}
 }
 
-func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
-	return map[string]map[string][]string{
+func (p AzureProvider) GetResourceConnections() map[string]map[string][]string {
+	connections := map[string]map[string][]string{
+		"aks": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"subnet": []string{
+				"default_node_pool.vnet_subnet_id", "id",
+				"default_node_pool.pod_subnet_id", "id",
+				"vnet_subnet_id", "id",
+				"pod_subnet_id", "id",
+			},
+			"log_analytics": []string{
+				"oms_agent.log_analytics_workspace_id", "id",
+				"microsoft_defender.log_analytics_workspace_id", "id",
+			},
+		},
 		"analysis": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
+		"api_management": {
+			"resource_group":       []string{"resource_group_name", "name"},
+			"subnet":               []string{"virtual_network_configuration.subnet_id", "id"},
+			"application_insights": []string{"resource_id", "id"},
+		},
 		"app_service": {
+			"resource_group":   []string{"resource_group_name", "name"},
+			"app_service_plan": []string{"service_plan_id", "id"},
+			"subnet": []string{
+				"subnet_id", "id",
+				"virtual_network_subnet_id", "id",
+			},
+		},
+		"app_service_plan": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
 		"application_gateway": {
 			"resource_group": []string{"resource_group_name", "name"},
+			"public_ip":      []string{"frontend_ip_configuration.public_ip_address_id", "id"},
+			"subnet": []string{
+				"gateway_ip_configuration.subnet_id", "id",
+				"frontend_ip_configuration.subnet_id", "id",
+			},
+		},
+		"automation": {
+			"resource_group": []string{"resource_group_name", "name"},
+		},
+		"backup": {
+			"resource_group":  []string{"resource_group_name", "name"},
+			"virtual_machine": []string{"source_vm_id", "id"},
+			"storage_account": []string{
+				"storage_account_id", "id",
+				"source_storage_account_id", "id",
+			},
+			"virtual_network": []string{
+				"source_network_id", "id",
+				"target_network_id", "id",
+			},
+			"disk": []string{"managed_disk.disk_id", "id"},
+		},
+		"bastion_host": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"subnet":         []string{"ip_configuration.subnet_id", "id"},
+			"public_ip":      []string{"ip_configuration.public_ip_address_id", "id"},
+		},
+		"cdn": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"app_service": []string{
+				"origin.host_name", "default_hostname",
+				"origin_host_header", "default_hostname",
+			},
+			"public_ip": []string{
+				"origin.host_name", "fqdn",
+				"origin.host_name", "ip_address",
+			},
+		},
+		"cdn_frontdoor": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"app_service": []string{
+				"host_name", "default_hostname",
+				"origin_host_header", "default_hostname",
+			},
+			"public_ip": []string{
+				"host_name", "fqdn",
+				"host_name", "ip_address",
+			},
+		},
+		"cognitive": {
+			"resource_group":  []string{"resource_group_name", "name"},
+			"subnet":          []string{"network_acls.virtual_network_rules.subnet_id", "id"},
+			"storage_account": []string{"storage.storage_account_id", "id"},
 		},
 		"cosmosdb": {
 			"resource_group": []string{
@@ -185,11 +279,26 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 				"location", "location",
 			},
 		},
+		"container_app": {
+			"resource_group":  []string{"resource_group_name", "name"},
+			"log_analytics":   []string{"log_analytics_workspace_id", "id"},
+			"subnet":          []string{"infrastructure_subnet_id", "id"},
+			"storage_account": []string{"account_name", "name"},
+			"container":       []string{"registry.server", "login_server"},
+		},
+		"application_insights": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"log_analytics":  []string{"workspace_id", "id"},
+		},
 		"database": {
 			"resource_group": []string{
 				"resource_group_name", "name",
 				"location", "location",
 			},
+			"subnet": []string{
+				"subnet_id", "id",
+				"delegated_subnet_id", "id",
+			},
 		},
 		"databricks": {
 			"resource_group": []string{
@@ -219,34 +328,117 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 			"keyvault":        []string{"keyvault_id", "id"},
 			"storage_account": []string{"storage_account_id", "id"},
 		},
+		"ddos_protection_plan": {
+			"resource_group": []string{"resource_group_name", "name"},
+		},
+		"diagnostic_setting": {
+			"log_analytics":   []string{"log_analytics_workspace_id", "id"},
+			"storage_account": []string{"storage_account_id", "id"},
+			"eventhub":        []string{"eventhub_authorization_rule_id", "id"},
+		},
 		"disk": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
 		"dns": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
+		"eventgrid": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"eventgrid": []string{
+				"system_topic", "name",
+				"domain_name", "name",
+			},
+			"eventhub": []string{"eventhub_endpoint_id", "id"},
+			"servicebus": []string{
+				"service_bus_queue_endpoint_id", "id",
+				"service_bus_topic_endpoint_id", "id",
+			},
+			"storage_account": []string{
+				"storage_queue_endpoint.storage_account_id", "id",
+				"storage_blob_dead_letter_destination.storage_account_id", "id",
+				"source_arm_resource_id", "id",
+			},
+			"keyvault": []string{"source_arm_resource_id", "id"},
+		},
 		"eventhub": {
 			"resource_group": []string{"resource_group_name", "name"},
 			"eventhub": []string{
 				"eventhub_name", "name",
 				"namespace_name", "name",
 			},
+			"subnet":          []string{"network_rulesets.virtual_network_rule.subnet_id", "id"},
+			"storage_account": []string{"capture_description.destination.storage_account_id", "id"},
+		},
+		"express_route": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"virtual_wan":    []string{"virtual_hub_id", "id"},
 		},
 		"keyvault": {
 			"resource_group": []string{
 				"resource_group_name", "name",
 				"location", "location",
 			},
+			"subnet": []string{"network_acls.virtual_network_subnet_ids", "id"},
+		},
+		"kusto": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"kusto":          []string{"cluster_name", "name"},
+			"subnet":         []string{"virtual_network_configuration.subnet_id", "id"},
+			"public_ip": []string{
+				"virtual_network_configuration.engine_public_ip_id", "id",
+				"virtual_network_configuration.data_management_public_ip_id", "id",
+			},
 		},
 		"load_balancer": {
+			"resource_group":  []string{"resource_group_name", "name"},
+			"public_ip":       []string{"frontend_ip_configuration.public_ip_address_id", "id"},
+			"subnet":          []string{"frontend_ip_configuration.subnet_id", "id"},
+			"virtual_network": []string{"virtual_network_id", "id"},
+		},
+		"log_analytics": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
+		"logic_app": {
+			"resource_group":   []string{"resource_group_name", "name"},
+			"subnet":           []string{"virtual_network_subnet_ids", "id"},
+			"app_service_plan": []string{"app_service_plan_id", "id"},
+			"storage_account":  []string{"storage_account_name", "name"},
+		},
+		"machine_learning": {
+			"resource_group":       []string{"resource_group_name", "name"},
+			"keyvault":             []string{"key_vault_id", "id"},
+			"storage_account":      []string{"storage_account_id", "id"},
+			"application_insights": []string{"application_insights_id", "id"},
+			"container":            []string{"container_registry_id", "id"},
+			"subnet":               []string{"subnet_resource_id", "id"},
+		},
+		"monitor": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"monitor": []string{
+				"action.action_group_id", "id",
+				"action.action_groups", "id",
+				"data_collection_endpoint_id", "id",
+			},
+			"log_analytics": []string{
+				"scopes", "id",
+				"destinations.log_analytics.workspace_resource_id", "id",
+			},
+			"application_insights": []string{"scopes", "id"},
+		},
+		"nat_gateway": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"public_ip": []string{
+				"public_ip_address_id", "id",
+				"public_ip_prefix_id", "id",
+			},
+		},
 		"network_interface": {
 			"resource_group": []string{
 				"resource_group_name", "name",
 				"location", "location",
 			},
-			"subnet": []string{"subnet_id", "id"},
+			"subnet":        []string{"subnet_id", "id"},
+			"load_balancer": []string{"backend_address_pool_id", "id"},
 		},
 		"network_security_group": {
 			"resource_group": []string{
@@ -270,13 +462,37 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 				"zone_name", "name",
 				"private_dns_zone_name", "name",
 			},
+			"subnet": []string{
+				"ip_configurations.subnet_id", "id",
+				"subnet_id", "id",
+			},
 		},
 		"private_endpoint": {
 			"resource_group": []string{
 				"resource_group_name", "name",
 				"location", "location",
 			},
-			"subnet": []string{"subnet_id", "id"},
+			"subnet": []string{
+				"subnet_id", "id",
+				"nat_ip_configuration.subnet_id", "id",
+			},
+			"private_dns":      []string{"private_dns_zone_group.private_dns_zone_ids", "id"},
+			"private_endpoint": []string{"private_service_connection.private_connection_resource_id", "id"},
+			"api_management":   []string{"private_service_connection.private_connection_resource_id", "id"},
+			"app_service":      []string{"private_service_connection.private_connection_resource_id", "id"},
+			"cognitive":        []string{"private_service_connection.private_connection_resource_id", "id"},
+			"container":        []string{"private_service_connection.private_connection_resource_id", "id"},
+			"cosmosdb":         []string{"private_service_connection.private_connection_resource_id", "id"},
+			"database":         []string{"private_service_connection.private_connection_resource_id", "id"},
+			"eventgrid":        []string{"private_service_connection.private_connection_resource_id", "id"},
+			"eventhub":         []string{"private_service_connection.private_connection_resource_id", "id"},
+			"keyvault":         []string{"private_service_connection.private_connection_resource_id", "id"},
+			"kusto":            []string{"private_service_connection.private_connection_resource_id", "id"},
+			"machine_learning": []string{"private_service_connection.private_connection_resource_id", "id"},
+			"redis":            []string{"private_service_connection.private_connection_resource_id", "id"},
+			"search":           []string{"private_service_connection.private_connection_resource_id", "id"},
+			"servicebus":       []string{"private_service_connection.private_connection_resource_id", "id"},
+			"storage_account":  []string{"private_service_connection.private_connection_resource_id", "id"},
 		},
 		"public_ip": {
 			"resource_group": []string{
@@ -297,9 +513,29 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 			},
 			"route_table": []string{"route_table_name", "name"},
 		},
+		"policy": {
+			"management_group": []string{"management_group_id", "id"},
+			"resource_group":   []string{"resource_group_id", "id"},
+		},
+		"role_assignment": {
+			"role_definition":        []string{"role_definition_id", "role_definition_resource_id"},
+			"user_assigned_identity": []string{"principal_id", "principal_id"},
+			"aks": []string{
+				"principal_id", "kubelet_identity.0.object_id",
+				"principal_id", "identity.0.principal_id",
+			},
+			"container": []string{"scope", "id"},
+		},
 		"scaleset": {
 			"resource_group": []string{"resource_group_name", "name"},
 		},
+		"search": {
+			"resource_group": []string{"resource_group_name", "name"},
+		},
+		"servicebus": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"subnet":         []string{"network_rule_set.network_rules.subnet_id", "id"},
+		},
 		"ssh_public_key": {
 			"resource_group": []string{
 				"resource_group_name", "name",
@@ -311,6 +547,8 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 				"resource_group_name", "name",
 				"location", "location",
 			},
+			"keyvault":        []string{"key_vault_id", "id"},
+			"subnet":          []string{"virtual_network_subnet_ids", "id"},
 			"virtual_network": []string{"virtual_network_subnet_ids", "id"},
 		},
 		"storage_blob": {
@@ -320,6 +558,16 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 		"storage_container": {
 			"storage_account": []string{"storage_account_name", "name"},
 		},
+		"storage_queue": {
+			"storage_account": []string{"storage_account_name", "name"},
+		},
+		"storage_share": {
+			"storage_account": []string{"storage_account_name", "name"},
+			"storage_share":   []string{"storage_share_id", "id"},
+		},
+		"storage_table": {
+			"storage_account": []string{"storage_account_name", "name"},
+		},
 		"synapse": {
 			"resource_group": []string{
 				"resource_group_name", "name",
@@ -333,18 +581,52 @@ func (AzureProvider) GetResourceConnections() map[string]map[string][]string {
 			"network_security_group": []string{"network_security_group_id", "id"},
 			"route_table":            []string{"route_table_id", "id"},
 			"subnet":                 []string{"subnet_id", "id"},
+			"nat_gateway":            []string{"nat_gateway_id", "id"},
+		},
+		"traffic_manager": {
+			"resource_group": []string{"resource_group_name", "name"},
+			"app_service":    []string{"target_resource_id", "id"},
+			"public_ip":      []string{"target_resource_id", "id"},
+		},
+		"user_assigned_identity": {
+			"resource_group": []string{
+				"resource_group_name", "name",
+				"location", "location",
+			},
 		},
 		"virtual_machine": {
 			"resource_group": []string{
 				"resource_group_name", "name",
 				"location", "location",
 			},
-			"network_interface": []string{"network_interface_ids", "id"},
+			"network_interface": []string{
+				"network_interface_ids", "id",
+				"primary_network_interface_id", "id",
+			},
+			"disk": []string{"managed_disk_id", "id"},
 		},
 		"virtual_network": {
+			"resource_group":       []string{"resource_group_name", "name"},
+			"ddos_protection_plan": []string{"ddos_protection_plan.id", "id"},
+		},
+		"virtual_network_gateway": {
 			"resource_group": []string{"resource_group_name", "name"},
+			"subnet":         []string{"ip_configuration.subnet_id", "id"},
+			"public_ip":      []string{"ip_configuration.public_ip_address_id", "id"},
+			"express_route":  []string{"express_route_circuit_id", "id"},
+		},
+		"virtual_wan": {
+			"resource_group":  []string{"resource_group_name", "name"},
+			"virtual_network": []string{"remote_virtual_network_id", "id"},
 		},
 	}
+	// diagnostic settings can be attached to a resource of any service
+	for service := range p.GetSupportedService() {
+		if service != "diagnostic_setting" {
+			connections["diagnostic_setting"][service] = append(connections["diagnostic_setting"][service], "target_resource_id", "id")
+		}
+	}
+	return connections
 }
 
 func (p *AzureProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
@@ -352,22 +634,44 @@ func (p *AzureProvider) GetSupportedService() map[string]terraformutils.ServiceG
 		"aks":                                  &AKSGenerator{},
 		"firewall":                             &FirewallGenerator{},
 		"analysis":                             &AnalysisGenerator{},
+		"api_management":                       &APIManagementGenerator{},
 		"app_service":                          &AppServiceGenerator{},
+		"app_service_plan":                     &AppServicePlanGenerator{},
 		"application_gateway":                  &ApplicationGatewayGenerator{},
+		"application_insights":                 &ApplicationInsightsGenerator{},
+		"automation":                           &AutomationGenerator{},
+		"backup":                               &BackupGenerator{},
+		"bastion_host":                         &BastionHostGenerator{},
+		"cdn":                                  &CdnGenerator{},
+		"cdn_frontdoor":                        &CdnFrontDoorGenerator{},
+		"cognitive":                            &CognitiveGenerator{},
 		"cosmosdb":                             &CosmosDBGenerator{},
 		"container":                            &ContainerGenerator{},
+		"container_app":                        &ContainerAppGenerator{},
 		"database":                             &DatabasesGenerator{},
 		"databricks":                           &DatabricksGenerator{},
 		"data_factory":                         &DataFactoryGenerator{},
+		"ddos_protection_plan":                 &DdosProtectionPlanGenerator{},
+		"diagnostic_setting":                   &DiagnosticSettingGenerator{},
 		"disk":                                 &DiskGenerator{},
 		"dns":                                  &DNSGenerator{},
+		"eventgrid":                            &EventGridGenerator{},
 		"eventhub":                             &EventHubGenerator{},
+		"express_route":                        &ExpressRouteGenerator{},
 		"keyvault":                             &KeyVaultGenerator{},
+		"kusto":                                &KustoGenerator{},
 		"load_balancer":                        &LoadBalancerGenerator{},
+		"log_analytics":                        &LogAnalyticsGenerator{},
+		"logic_app":                            &LogicAppGenerator{},
+		"machine_learning":                     &MachineLearningGenerator{},
+		"management_group":                     &ManagementGroupGenerator{},
 		"management_lock":                      &ManagementLockGenerator{},
+		"monitor":                              &MonitorGenerator{},
+		"nat_gateway":                          &NatGatewayGenerator{},
 		"network_interface":                    &NetworkInterfaceGenerator{},
 		"network_security_group":               &NetworkSecurityGroupGenerator{},
 		"network_watcher":                      &NetworkWatcherGenerator{},
+		"policy":                               &PolicyGenerator{},
 		"private_dns":                          &PrivateDNSGenerator{},
 		"private_endpoint":                     &PrivateEndpointGenerator{},
 		"public_ip":                            &PublicIPGenerator{},
@@ -375,17 +679,28 @@ func (p *AzureProvider) GetSupportedService() map[string]terraformutils.ServiceG
 		"redis":                                &RedisGenerator{},
 		"resource_group":                       &ResourceGroupGenerator{},
 		"route_table":                          &RouteTableGenerator{},
+		"role_assignment":                      &RoleAssignmentGenerator{},
+		"role_definition":                      &RoleDefinitionGenerator{},
 		"scaleset":                             &ScaleSetGenerator{},
+		"search":                               &SearchGenerator{},
 		"security_center_contact":              &SecurityCenterContactGenerator{},
 		"security_center_subscription_pricing": &SecurityCenterSubscriptionPricingGenerator{},
+		"servicebus":                           &ServiceBusGenerator{},
 		"ssh_public_key":                       &SSHPublicKeyGenerator{},
 		"storage_account":                      &StorageAccountGenerator{},
 		"storage_blob":                         &StorageBlobGenerator{},
 		"storage_container":                    &StorageContainerGenerator{},
+		"storage_queue":                        &StorageQueueGenerator{},
+		"storage_share":                        &StorageShareGenerator{},
+		"storage_table":                        &StorageTableGenerator{},
 		"synapse":                              &SynapseGenerator{},
 		"subnet":                               &SubnetGenerator{},
+		"traffic_manager":                      &TrafficManagerGenerator{},
+		"user_assigned_identity":               &UserAssignedIdentityGenerator{},
 		"virtual_machine":                      &VirtualMachineGenerator{},
 		"virtual_network":                      &VirtualNetworkGenerator{},
+		"virtual_network_gateway":              &VirtualNetworkGatewayGenerator{},
+		"virtual_wan":                          &VirtualWanGenerator{},
 	}
 }
 
@@ -399,9 +714,11 @@ func (p *AzureProvider) InitService(serviceName string, verbose bool) error {
 	p.Service.SetVerbose(verbose)
 	p.Service.SetProviderName(p.GetName())
 	p.Service.SetArgs(map[string]interface{}{
-		"config":         p.config,
-		"authorizer":     p.authorizer,
-		"resource_group": p.resourceGroup,
+		"config":              p.config,
+		"authorizer":          p.authorizer,
+		"keyvault_authorizer": p.keyVaultAuthorizer,
+		"resource_group":      p.resourceGroup,
+		"management_group":    p.managementGroup,
 	})
 	return nil
 }
@@ -15,6 +15,7 @@
 package azure
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
@@ -34,6 +35,26 @@ func (az *AzureService) getClientArgs() (subscriptionID string, resourceGroup st
 	return subs, resg, auth, rEndpoint
 }
 
+// getManagementGroup returns the management group given with --management-group, if any
+func (az *AzureService) getManagementGroup() string {
+	managementGroup, _ := az.Args["management_group"].(string)
+	return managementGroup
+}
+
+// resourceGroups returns the resource groups given with -R, or a single empty
+// name standing for the whole subscription
+func (az *AzureService) resourceGroups() []string {
+	_, resourceGroup, _, _ := az.getClientArgs()
+	if resourceGroup == "" {
+		return []string{""}
+	}
+	var resourceGroups []string
+	for _, rgName := range strings.Split(resourceGroup, ",") {
+		resourceGroups = append(resourceGroups, strings.TrimSpace(rgName))
+	}
+	return resourceGroups
+}
+
 func (az *AzureService) AppendSimpleResource(id string, resourceName string, resourceType string) {
 	newResource := terraformutils.NewSimpleResource(id, resourceName, resourceType, az.ProviderName, []string{})
 	az.Resources = append(az.Resources, newResource)
@@ -83,3 +104,33 @@ func (az *AzureService) appendSimpleAssociation(id string, linkedResourceName st
 	)
 	az.Resources = append(az.Resources, newResource)
 }
+
+// linkResourceIDs replaces the IDs in the given attributes, or in the given lists of IDs,
+// with references to the resources of this service they point to, for use in PostConvertHook
+func (az *AzureService) linkResourceIDs(keys ...string) {
+	for i, r := range az.Resources {
+		for _, key := range keys {
+			switch value := r.Item[key].(type) {
+			case string:
+				az.Resources[i].Item[key] = az.resourceIDReference(value)
+			case []interface{}:
+				for j, element := range value {
+					if id, ok := element.(string); ok {
+						value[j] = az.resourceIDReference(id)
+					}
+				}
+			}
+		}
+	}
+}
+
+// resourceIDReference returns a reference to the resource of this service with the ID,
+// or the ID itself
+func (az *AzureService) resourceIDReference(id string) string {
+	for _, target := range az.Resources {
+		if strings.EqualFold(id, target.InstanceState.ID) {
+			return fmt.Sprintf("${%s.%s}", target.InstanceInfo.Id, "id")
+		}
+	}
+	return id
+}
@@ -0,0 +1,381 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/dataprotection/mgmt/2021-07-01/dataprotection"
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2018-07-10/siterecovery"
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2021-08-01/recoveryservices"
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2021-12-01/backup"
+)
+
+type BackupGenerator struct {
+	AzureService
+}
+
+// dataProtectionPolicyTypes maps the datasource types of Backup vault policies to their resource type
+var dataProtectionPolicyTypes = map[string]string{
+	"Microsoft.Compute/disks":                        "azurerm_data_protection_backup_policy_disk",
+	"Microsoft.Storage/storageAccounts/blobServices": "azurerm_data_protection_backup_policy_blob_storage",
+	"Microsoft.DBforPostgreSQL/servers/databases":    "azurerm_data_protection_backup_policy_postgresql",
+}
+
+func (az *BackupGenerator) appendBackupPolicies(resourceGroup, vaultName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := backup.NewPoliciesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, vaultName, resourceGroup, "")
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		policy := iterator.Value()
+		resourceName := vaultName + "_" + *policy.Name
+		switch policy.Properties.(type) {
+		case backup.AzureIaaSVMProtectionPolicy:
+			az.AppendSimpleResource(*policy.ID, resourceName, "azurerm_backup_policy_vm")
+		case backup.AzureFileShareProtectionPolicy:
+			az.AppendSimpleResource(*policy.ID, resourceName, "azurerm_backup_policy_file_share")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// appendStorageContainers appends the storage accounts registered with the vault to back up their shares
+func (az *BackupGenerator) appendStorageContainers(resourceGroup, vaultName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := backup.NewProtectionContainersGroupClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, vaultName, resourceGroup, "backupManagementType eq 'AzureStorage'")
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		container := iterator.Value()
+		if _, ok := container.Properties.(backup.AzureStorageContainer); ok {
+			az.AppendSimpleResource(*container.ID, vaultName+"_"+*container.Name, "azurerm_backup_container_storage_account")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *BackupGenerator) appendProtectedItems(resourceGroup, vaultName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := backup.NewProtectedItemsGroupClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, vaultName, resourceGroup, "", "")
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		resourceName := vaultName + "_" + *item.Name
+		switch item.Properties.(type) {
+		case backup.AzureIaaSComputeVMProtectedItem:
+			az.AppendSimpleResource(*item.ID, resourceName, "azurerm_backup_protected_vm")
+		case backup.AzureFileshareProtectedItem:
+			az.AppendSimpleResource(*item.ID, resourceName, "azurerm_backup_protected_file_share")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *BackupGenerator) appendReplicationPolicies(resourceGroup, vaultName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := siterecovery.NewReplicationPoliciesClientWithBaseURI(resourceManagerEndpoint, subscriptionID, resourceGroup, vaultName)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		policy := iterator.Value()
+		if policy.Properties != nil && policy.Properties.ProviderSpecificDetails != nil {
+			if _, ok := policy.Properties.ProviderSpecificDetails.AsA2APolicyDetails(); ok {
+				az.AppendSimpleResource(*policy.ID, vaultName+"_"+*policy.Name, "azurerm_site_recovery_replication_policy")
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *BackupGenerator) appendNetworkMappings(resourceGroup, vaultName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := siterecovery.NewReplicationNetworkMappingsClientWithBaseURI(resourceManagerEndpoint, subscriptionID, resourceGroup, vaultName)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		mapping := iterator.Value()
+		if mapping.Properties != nil && mapping.Properties.FabricSpecificSettings != nil {
+			if _, ok := mapping.Properties.FabricSpecificSettings.AsAzureToAzureNetworkMappingSettings(); ok {
+				az.AppendSimpleResource(*mapping.ID, vaultName+"_"+*mapping.Name, "azurerm_site_recovery_network_mapping")
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// appendProtectionContainers appends the containers of an Azure fabric, with their mappings
+// to the containers of the recovery fabric and the virtual machines they replicate
+func (az *BackupGenerator) appendProtectionContainers(resourceGroup, vaultName, fabricName, parentName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := siterecovery.NewReplicationProtectionContainersClientWithBaseURI(resourceManagerEndpoint, subscriptionID, resourceGroup, vaultName)
+	client.Authorizer = authorizer
+	mappingsClient := siterecovery.NewReplicationProtectionContainerMappingsClientWithBaseURI(resourceManagerEndpoint, subscriptionID, resourceGroup, vaultName)
+	mappingsClient.Authorizer = authorizer
+	itemsClient := siterecovery.NewReplicationProtectedItemsClientWithBaseURI(resourceManagerEndpoint, subscriptionID, resourceGroup, vaultName)
+	itemsClient.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByReplicationFabricsComplete(ctx, fabricName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		container := iterator.Value()
+		containerName := parentName + "_" + *container.Name
+		az.AppendSimpleResource(*container.ID, containerName, "azurerm_site_recovery_protection_container")
+		mappings, err := mappingsClient.ListByReplicationProtectionContainersComplete(ctx, fabricName, *container.Name)
+		if err != nil {
+			return err
+		}
+		for mappings.NotDone() {
+			mapping := mappings.Value()
+			az.AppendSimpleResource(*mapping.ID, containerName+"_"+*mapping.Name, "azurerm_site_recovery_protection_container_mapping")
+			if err := mappings.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return err
+			}
+		}
+		items, err := itemsClient.ListByReplicationProtectionContainersComplete(ctx, fabricName, *container.Name)
+		if err != nil {
+			return err
+		}
+		for items.NotDone() {
+			item := items.Value()
+			if item.Properties != nil && item.Properties.ProviderSpecificDetails != nil {
+				if _, ok := item.Properties.ProviderSpecificDetails.AsA2AReplicationDetails(); ok {
+					az.AppendSimpleResource(*item.ID, vaultName+"_"+*item.Name, "azurerm_site_recovery_replicated_vm")
+				}
+			}
+			if err := items.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return err
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// appendSiteRecovery appends the Azure to Azure replication set up in the vault, fabrics
+// of on-premises sites are registered by their servers and skipped
+func (az *BackupGenerator) appendSiteRecovery(resourceGroup, vaultName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := siterecovery.NewReplicationFabricsClientWithBaseURI(resourceManagerEndpoint, subscriptionID, resourceGroup, vaultName)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		fabric := iterator.Value()
+		if fabric.Properties != nil && fabric.Properties.CustomDetails != nil {
+			if _, ok := fabric.Properties.CustomDetails.AsAzureFabricSpecificDetails(); ok {
+				fabricName := vaultName + "_" + *fabric.Name
+				az.AppendSimpleResource(*fabric.ID, fabricName, "azurerm_site_recovery_fabric")
+				if err := az.appendProtectionContainers(resourceGroup, vaultName, *fabric.Name, fabricName); err != nil {
+					return err
+				}
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	if err := az.appendReplicationPolicies(resourceGroup, vaultName); err != nil {
+		return err
+	}
+	return az.appendNetworkMappings(resourceGroup, vaultName)
+}
+
+func (az *BackupGenerator) appendRecoveryServicesVaults(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := recoveryservices.NewVaultsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator recoveryservices.VaultListIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionIDComplete(ctx)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		vault := iterator.Value()
+		az.AppendSimpleResource(*vault.ID, *vault.Name, "azurerm_recovery_services_vault")
+		id, err := ParseAzureResourceID(*vault.ID)
+		if err != nil {
+			return err
+		}
+		appendFuncs := []func(string, string) error{
+			az.appendBackupPolicies,
+			az.appendStorageContainers,
+			az.appendProtectedItems,
+			az.appendSiteRecovery,
+		}
+		for _, appendFunc := range appendFuncs {
+			if err := appendFunc(id.ResourceGroup, *vault.Name); err != nil {
+				return err
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *BackupGenerator) appendDataProtectionPolicies(resourceGroup, vaultName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := dataprotection.NewBackupPoliciesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, vaultName, resourceGroup)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		policy := iterator.Value()
+		if policy.Properties != nil {
+			if properties, ok := policy.Properties.AsBackupPolicy(); ok && properties.DatasourceTypes != nil {
+				for _, datasourceType := range *properties.DatasourceTypes {
+					if resourceType, ok := dataProtectionPolicyTypes[datasourceType]; ok {
+						az.AppendSimpleResource(*policy.ID, vaultName+"_"+*policy.Name, resourceType)
+						break
+					}
+				}
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *BackupGenerator) appendBackupVaults(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := dataprotection.NewBackupVaultsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator dataprotection.BackupVaultResourceListIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.GetInSubscriptionComplete(ctx)
+	} else {
+		iterator, err = client.GetInResourceGroupComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		vault := iterator.Value()
+		az.AppendSimpleResource(*vault.ID, *vault.Name, "azurerm_data_protection_backup_vault")
+		id, err := ParseAzureResourceID(*vault.ID)
+		if err != nil {
+			return err
+		}
+		if err := az.appendDataProtectionPolicies(id.ResourceGroup, *vault.Name); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *BackupGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendRecoveryServicesVaults(rgName); err != nil {
+			return err
+		}
+		if err := az.appendBackupVaults(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links policies, protected items and replication resources to their imported parents
+func (az *BackupGenerator) PostConvertHook() error {
+	az.linkResourceIDs(
+		"backup_policy_id",
+		"vault_id",
+		"recovery_replication_policy_id",
+		"recovery_target_protection_container_id",
+		"target_recovery_fabric_id",
+		"target_recovery_protection_container_id",
+	)
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+)
+
+type CdnGenerator struct {
+	AzureService
+}
+
+// listCdnProfiles returns the CDN profiles, Front Door Standard and Premium profiles included
+func (az *AzureService) listCdnProfiles() ([]cdn.Profile, error) {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewProfilesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var iterators []cdn.ProfileListResultIterator
+	if resourceGroup != "" {
+		for _, rgName := range strings.Split(resourceGroup, ",") {
+			iterator, err := client.ListByResourceGroupComplete(ctx, strings.TrimSpace(rgName))
+			if err != nil {
+				return nil, err
+			}
+			iterators = append(iterators, iterator)
+		}
+	} else {
+		iterator, err := client.ListComplete(ctx)
+		if err != nil {
+			return nil, err
+		}
+		iterators = append(iterators, iterator)
+	}
+	var profiles []cdn.Profile
+	for _, iterator := range iterators {
+		for iterator.NotDone() {
+			profiles = append(profiles, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return profiles, err
+			}
+		}
+	}
+	return profiles, nil
+}
+
+// isFrontDoorProfile reports whether the profile is a Front Door Standard or Premium profile
+func isFrontDoorProfile(profile cdn.Profile) bool {
+	return profile.Sku != nil &&
+		(profile.Sku.Name == cdn.SkuNameStandardAzureFrontDoor || profile.Sku.Name == cdn.SkuNamePremiumAzureFrontDoor)
+}
+
+func (az *CdnGenerator) appendEndpoints(resourceGroup, profileName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewEndpointsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByProfileComplete(ctx, resourceGroup, profileName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, profileName+"_"+*item.Name, "azurerm_cdn_endpoint")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CdnGenerator) InitResources() error {
+	profiles, err := az.listCdnProfiles()
+	if err != nil {
+		return err
+	}
+	for _, profile := range profiles {
+		if isFrontDoorProfile(profile) {
+			continue
+		}
+		az.AppendSimpleResource(*profile.ID, *profile.Name, "azurerm_cdn_profile")
+		id, err := ParseAzureResourceID(*profile.ID)
+		if err != nil {
+			return err
+		}
+		if err := az.appendEndpoints(id.ResourceGroup, *profile.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+)
+
+type CdnFrontDoorGenerator struct {
+	AzureService
+}
+
+func (az *CdnFrontDoorGenerator) appendRoutes(resourceGroup, profileName, endpointName, parentName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewRoutesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByEndpointComplete(ctx, resourceGroup, profileName, endpointName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, parentName+"_"+*item.Name, "azurerm_cdn_frontdoor_route")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CdnFrontDoorGenerator) appendEndpoints(resourceGroup, profileName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewAFDEndpointsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByProfileComplete(ctx, resourceGroup, profileName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		endpointName := profileName + "_" + *item.Name
+		az.AppendSimpleResource(*item.ID, endpointName, "azurerm_cdn_frontdoor_endpoint")
+		if err := az.appendRoutes(resourceGroup, profileName, *item.Name, endpointName); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CdnFrontDoorGenerator) appendOrigins(resourceGroup, profileName, originGroupName, parentName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewAFDOriginsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByOriginGroupComplete(ctx, resourceGroup, profileName, originGroupName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, parentName+"_"+*item.Name, "azurerm_cdn_frontdoor_origin")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CdnFrontDoorGenerator) appendOriginGroups(resourceGroup, profileName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewAFDOriginGroupsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByProfileComplete(ctx, resourceGroup, profileName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		originGroupName := profileName + "_" + *item.Name
+		az.AppendSimpleResource(*item.ID, originGroupName, "azurerm_cdn_frontdoor_origin_group")
+		if err := az.appendOrigins(resourceGroup, profileName, *item.Name, originGroupName); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CdnFrontDoorGenerator) appendRules(resourceGroup, profileName, ruleSetName, parentName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewRulesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByRuleSetComplete(ctx, resourceGroup, profileName, ruleSetName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, parentName+"_"+*item.Name, "azurerm_cdn_frontdoor_rule")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CdnFrontDoorGenerator) appendRuleSets(resourceGroup, profileName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewRuleSetsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByProfileComplete(ctx, resourceGroup, profileName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		ruleSetName := profileName + "_" + *item.Name
+		az.AppendSimpleResource(*item.ID, ruleSetName, "azurerm_cdn_frontdoor_rule_set")
+		if err := az.appendRules(resourceGroup, profileName, *item.Name, ruleSetName); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CdnFrontDoorGenerator) appendCustomDomains(resourceGroup, profileName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewAFDCustomDomainsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByProfileComplete(ctx, resourceGroup, profileName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, profileName+"_"+*item.Name, "azurerm_cdn_frontdoor_custom_domain")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CdnFrontDoorGenerator) appendSecrets(resourceGroup, profileName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewSecretsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByProfileComplete(ctx, resourceGroup, profileName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, profileName+"_"+*item.Name, "azurerm_cdn_frontdoor_secret")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CdnFrontDoorGenerator) appendSecurityPolicies(resourceGroup, profileName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cdn.NewSecurityPoliciesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByProfileComplete(ctx, resourceGroup, profileName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, profileName+"_"+*item.Name, "azurerm_cdn_frontdoor_security_policy")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CdnFrontDoorGenerator) InitResources() error {
+	profiles, err := az.listCdnProfiles()
+	if err != nil {
+		return err
+	}
+	for _, profile := range profiles {
+		if !isFrontDoorProfile(profile) {
+			continue
+		}
+		az.AppendSimpleResource(*profile.ID, *profile.Name, "azurerm_cdn_frontdoor_profile")
+		id, err := ParseAzureResourceID(*profile.ID)
+		if err != nil {
+			return err
+		}
+		functions := []func(resourceGroup, profileName string) error{
+			az.appendEndpoints,
+			az.appendOriginGroups,
+			az.appendRuleSets,
+			az.appendCustomDomains,
+			az.appendSecrets,
+			az.appendSecurityPolicies,
+		}
+		for _, f := range functions {
+			if err := f(id.ResourceGroup, *profile.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links the children of the profiles to each other
+func (az *CdnFrontDoorGenerator) PostConvertHook() error {
+	az.linkResourceIDs(
+		"cdn_frontdoor_profile_id",
+		"cdn_frontdoor_endpoint_id",
+		"cdn_frontdoor_origin_group_id",
+		"cdn_frontdoor_origin_ids",
+		"cdn_frontdoor_rule_set_id",
+		"cdn_frontdoor_rule_set_ids",
+		"cdn_frontdoor_custom_domain_ids",
+	)
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/cognitiveservices/mgmt/2022-03-01/cognitiveservices"
+)
+
+type CognitiveGenerator struct {
+	AzureService
+}
+
+func (az *CognitiveGenerator) appendDeployments(resourceGroup, accountName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cognitiveservices.NewDeploymentsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, resourceGroup, accountName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, accountName+"_"+*item.Name, "azurerm_cognitive_deployment")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// appendAccounts appends the accounts of all kinds, OpenAI included, and the model
+// deployments of the accounts supporting them
+func (az *CognitiveGenerator) appendAccounts(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := cognitiveservices.NewAccountsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator cognitiveservices.AccountListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListComplete(ctx)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		account := iterator.Value()
+		az.AppendSimpleResource(*account.ID, *account.Name, "azurerm_cognitive_account")
+		id, err := ParseAzureResourceID(*account.ID)
+		if err != nil {
+			return err
+		}
+		// only some kinds have deployments, the others answer with an error
+		if err := az.appendDeployments(id.ResourceGroup, *account.Name); err != nil {
+			log.Println(err)
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *CognitiveGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendAccounts(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links the deployments to the imported accounts
+func (az *CognitiveGenerator) PostConvertHook() error {
+	az.linkResourceIDs("cognitive_account_id")
+	return nil
+}
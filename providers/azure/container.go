@@ -17,9 +17,11 @@ package azure
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-10-01/containerinstance"
 	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2019-05-01/containerregistry"
+	containerregistrypreview "github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2019-06-01-preview/containerregistry"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/hashicorp/go-azure-helpers/authentication"
@@ -97,6 +99,125 @@ func (g *ContainerGenerator) listRegistryWebhooks(resourceGroupName string, regi
 	return resources, nil
 }
 
+// listRegistryReplications lists the replications of the registry, except the one of its home region
+// which is part of the registry
+func (g *ContainerGenerator) listRegistryReplications(resourceGroupName string, registryName string, location string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+	ReplicationsClient := containerregistrypreview.NewReplicationsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	ReplicationsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+
+	replicationIterator, err := ReplicationsClient.ListComplete(ctx, resourceGroupName, registryName)
+	if err != nil {
+		return nil, err
+	}
+	for replicationIterator.NotDone() {
+		replication := replicationIterator.Value()
+		if !strings.EqualFold(*replication.Location, location) {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*replication.ID,
+				registryName+"_"+*replication.Name,
+				"azurerm_container_registry_replication",
+				g.ProviderName,
+				[]string{}))
+		}
+		if err := replicationIterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+// listRegistryScopeMaps lists the scope maps of the registry, except the system defined ones
+func (g *ContainerGenerator) listRegistryScopeMaps(resourceGroupName string, registryName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+	ScopeMapsClient := containerregistrypreview.NewScopeMapsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	ScopeMapsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+
+	scopeMapIterator, err := ScopeMapsClient.ListComplete(ctx, resourceGroupName, registryName)
+	if err != nil {
+		return nil, err
+	}
+	for scopeMapIterator.NotDone() {
+		scopeMap := scopeMapIterator.Value()
+		if scopeMap.ScopeMapProperties == nil || scopeMap.ScopeMapProperties.Type == nil || *scopeMap.ScopeMapProperties.Type != "SystemDefined" {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*scopeMap.ID,
+				registryName+"_"+*scopeMap.Name,
+				"azurerm_container_registry_scope_map",
+				g.ProviderName,
+				[]string{}))
+		}
+		if err := scopeMapIterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *ContainerGenerator) listRegistryTokens(resourceGroupName string, registryName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+	TokensClient := containerregistrypreview.NewTokensClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	TokensClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+
+	tokenIterator, err := TokensClient.ListComplete(ctx, resourceGroupName, registryName)
+	if err != nil {
+		return nil, err
+	}
+	for tokenIterator.NotDone() {
+		token := tokenIterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*token.ID,
+			registryName+"_"+*token.Name,
+			"azurerm_container_registry_token",
+			g.ProviderName,
+			[]string{}))
+		if err := tokenIterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (g *ContainerGenerator) listRegistryTasks(resourceGroupName string, registryName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+	TasksClient := containerregistrypreview.NewTasksClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	TasksClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+
+	taskIterator, err := TasksClient.ListComplete(ctx, resourceGroupName, registryName)
+	if err != nil {
+		return nil, err
+	}
+	for taskIterator.NotDone() {
+		task := taskIterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*task.ID,
+			registryName+"_"+*task.Name,
+			"azurerm_container_registry_task",
+			g.ProviderName,
+			[]string{}))
+		if err := taskIterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
 func (g *ContainerGenerator) listAndAddForContainerRegistry() ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	ctx := context.Background()
@@ -138,6 +259,25 @@ func (g *ContainerGenerator) listAndAddForContainerRegistry() ([]terraformutils.
 		}
 		resources = append(resources, webhooks...)
 
+		replications, err := g.listRegistryReplications(id.ResourceGroup, *containerRegistry.Name, *containerRegistry.Location)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, replications...)
+
+		registryResourceLists := []func(string, string) ([]terraformutils.Resource, error){
+			g.listRegistryScopeMaps,
+			g.listRegistryTokens,
+			g.listRegistryTasks,
+		}
+		for _, f := range registryResourceLists {
+			registryResources, err := f(id.ResourceGroup, *containerRegistry.Name)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, registryResources...)
+		}
+
 		if err := containerRegistryIterator.Next(); err != nil {
 			log.Println(err)
 			return resources, err
@@ -0,0 +1,102 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/secrets"
+)
+
+// containerAppsAPIVersion is the Microsoft.App API version, the vendored SDK has no client for it
+const containerAppsAPIVersion = "2023-05-01"
+
+type ContainerAppGenerator struct {
+	AzureService
+}
+
+// containerAppsPath returns the path listing the resources of the type in the resource group,
+// or in the subscription when rgName is empty
+func containerAppsPath(rgName, resourceType string) string {
+	if rgName == "" {
+		return "/subscriptions/{subscriptionId}/providers/Microsoft.App/" + resourceType
+	}
+	return "/subscriptions/{subscriptionId}/resourceGroups/" + rgName + "/providers/Microsoft.App/" + resourceType
+}
+
+func (az *ContainerAppGenerator) appendEnvironments(rgName string) error {
+	environments, err := az.listARMResources(containerAppsPath(rgName, "managedEnvironments"), containerAppsAPIVersion)
+	if err != nil {
+		return err
+	}
+	children := []struct {
+		path         string
+		resourceType string
+	}{
+		{"/certificates", "azurerm_container_app_environment_certificate"},
+		{"/storages", "azurerm_container_app_environment_storage"},
+		{"/daprComponents", "azurerm_container_app_environment_dapr_component"},
+	}
+	for _, environment := range environments {
+		az.AppendSimpleResource(environment.ID, environment.Name, "azurerm_container_app_environment")
+		for _, child := range children {
+			resources, err := az.listARMResources(environment.ID+child.path, containerAppsAPIVersion)
+			if err != nil {
+				return err
+			}
+			for _, resource := range resources {
+				az.AppendSimpleResource(resource.ID, environment.Name+"_"+resource.Name, child.resourceType)
+			}
+		}
+	}
+	return nil
+}
+
+func (az *ContainerAppGenerator) appendContainerApps(rgName string) error {
+	apps, err := az.listARMResources(containerAppsPath(rgName, "containerApps"), containerAppsAPIVersion)
+	if err != nil {
+		return err
+	}
+	for _, app := range apps {
+		az.AppendSimpleResource(app.ID, app.Name, "azurerm_container_app")
+	}
+	return nil
+}
+
+func (az *ContainerAppGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendEnvironments(rgName); err != nil {
+			return err
+		}
+		if err := az.appendContainerApps(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links apps and environment resources to their environment and replaces
+// the certificates and storage keys Azure doesn't return with variables
+func (az *ContainerAppGenerator) PostConvertHook() error {
+	for i, r := range az.Resources {
+		switch r.InstanceInfo.Type {
+		case "azurerm_container_app_environment_certificate":
+			az.Resources[i].Item["certificate_blob_base64"] = secrets.AddVariable(&az.Resources[i], []string{"certificate_blob_base64"})
+			az.Resources[i].Item["certificate_password"] = secrets.AddVariable(&az.Resources[i], []string{"certificate_password"})
+		case "azurerm_container_app_environment_storage":
+			az.Resources[i].Item["access_key"] = secrets.AddVariable(&az.Resources[i], []string{"access_key"})
+		}
+	}
+	az.linkResourceIDs("container_app_environment_id")
+	return nil
+}
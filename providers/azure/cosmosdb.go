@@ -16,6 +16,7 @@ package azure
 
 import (
 	"context"
+	"log"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-06-15/documentdb"
@@ -72,12 +73,284 @@ func (g *CosmosDBGenerator) listSQLDatabasesAndContainersBehind(resourceGroupNam
 				"azurerm_cosmosdb_sql_container",
 				g.ProviderName,
 				[]string{}))
+
+			scripts, err := g.listSQLContainerScripts(resourceGroupName, accountName, *sqlDatabase.Name, *sqlContainer.Name)
+			if err != nil {
+				log.Printf("can't list the scripts of container %s: %v", *sqlContainer.Name, err)
+			}
+			resourcesContainer = append(resourcesContainer, scripts...)
 		}
 	}
 
 	return resourcesDatabase, resourcesContainer, nil
 }
 
+func (g *CosmosDBGenerator) listSQLResources(resourceGroupName string, accountName string) ([]terraformutils.Resource, error) {
+	sqlDatabases, sqlContainers, err := g.listSQLDatabasesAndContainersBehind(resourceGroupName, accountName)
+	if err != nil {
+		return nil, err
+	}
+	return append(sqlDatabases, sqlContainers...), nil
+}
+
+// listSQLContainerScripts lists the stored procedures, triggers and user defined functions of the container
+func (g *CosmosDBGenerator) listSQLContainerScripts(resourceGroupName string, accountName string, databaseName string, containerName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+	SQLResourcesClient := documentdb.NewSQLResourcesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	SQLResourcesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	prefix := accountName + "_" + databaseName + "_" + containerName + "_"
+
+	storedProcedures, err := SQLResourcesClient.ListSQLStoredProcedures(ctx, resourceGroupName, accountName, databaseName, containerName)
+	if err != nil {
+		return nil, err
+	}
+	if storedProcedures.Value != nil {
+		for _, storedProcedure := range *storedProcedures.Value {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*storedProcedure.ID,
+				prefix+*storedProcedure.Name,
+				"azurerm_cosmosdb_sql_stored_procedure",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+
+	triggers, err := SQLResourcesClient.ListSQLTriggers(ctx, resourceGroupName, accountName, databaseName, containerName)
+	if err != nil {
+		return nil, err
+	}
+	if triggers.Value != nil {
+		for _, trigger := range *triggers.Value {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*trigger.ID,
+				prefix+*trigger.Name,
+				"azurerm_cosmosdb_sql_trigger",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+
+	functions, err := SQLResourcesClient.ListSQLUserDefinedFunctions(ctx, resourceGroupName, accountName, databaseName, containerName)
+	if err != nil {
+		return nil, err
+	}
+	if functions.Value != nil {
+		for _, function := range *functions.Value {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*function.ID,
+				prefix+*function.Name,
+				"azurerm_cosmosdb_sql_function",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+
+	return resources, nil
+}
+
+// listSQLRoles lists the custom SQL role definitions of the account and the role assignments
+func (g *CosmosDBGenerator) listSQLRoles(resourceGroupName string, accountName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+	SQLResourcesClient := documentdb.NewSQLResourcesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	SQLResourcesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+
+	roleDefinitions, err := SQLResourcesClient.ListSQLRoleDefinitions(ctx, resourceGroupName, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if roleDefinitions.Value != nil {
+		for _, roleDefinition := range *roleDefinitions.Value {
+			if roleDefinition.SQLRoleDefinitionResource != nil && roleDefinition.SQLRoleDefinitionResource.Type == documentdb.RoleDefinitionTypeBuiltInRole {
+				continue
+			}
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*roleDefinition.ID,
+				accountName+"_"+*roleDefinition.Name,
+				"azurerm_cosmosdb_sql_role_definition",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+
+	roleAssignments, err := SQLResourcesClient.ListSQLRoleAssignments(ctx, resourceGroupName, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if roleAssignments.Value != nil {
+		for _, roleAssignment := range *roleAssignments.Value {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*roleAssignment.ID,
+				accountName+"_"+*roleAssignment.Name,
+				"azurerm_cosmosdb_sql_role_assignment",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+
+	return resources, nil
+}
+
+func (g *CosmosDBGenerator) listMongoDatabasesAndCollections(resourceGroupName string, accountName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+	MongoDBResourcesClient := documentdb.NewMongoDBResourcesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	MongoDBResourcesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+
+	databases, err := MongoDBResourcesClient.ListMongoDBDatabases(ctx, resourceGroupName, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if databases.Value == nil {
+		return resources, nil
+	}
+	for _, database := range *databases.Value {
+		databaseName := accountName + "_" + *database.Name
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*database.ID,
+			databaseName,
+			"azurerm_cosmosdb_mongo_database",
+			g.ProviderName,
+			[]string{}))
+
+		collections, err := MongoDBResourcesClient.ListMongoDBCollections(ctx, resourceGroupName, accountName, *database.Name)
+		if err != nil {
+			return nil, err
+		}
+		if collections.Value == nil {
+			continue
+		}
+		for _, collection := range *collections.Value {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*collection.ID,
+				databaseName+"_"+*collection.Name,
+				"azurerm_cosmosdb_mongo_collection",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+
+	return resources, nil
+}
+
+func (g *CosmosDBGenerator) listCassandraKeyspacesAndTables(resourceGroupName string, accountName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+	CassandraResourcesClient := documentdb.NewCassandraResourcesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	CassandraResourcesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+
+	keyspaces, err := CassandraResourcesClient.ListCassandraKeyspaces(ctx, resourceGroupName, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if keyspaces.Value == nil {
+		return resources, nil
+	}
+	for _, keyspace := range *keyspaces.Value {
+		keyspaceName := accountName + "_" + *keyspace.Name
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*keyspace.ID,
+			keyspaceName,
+			"azurerm_cosmosdb_cassandra_keyspace",
+			g.ProviderName,
+			[]string{}))
+
+		tables, err := CassandraResourcesClient.ListCassandraTables(ctx, resourceGroupName, accountName, *keyspace.Name)
+		if err != nil {
+			return nil, err
+		}
+		if tables.Value == nil {
+			continue
+		}
+		for _, table := range *tables.Value {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*table.ID,
+				keyspaceName+"_"+*table.Name,
+				"azurerm_cosmosdb_cassandra_table",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+
+	return resources, nil
+}
+
+func (g *CosmosDBGenerator) listGremlinDatabasesAndGraphs(resourceGroupName string, accountName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+	GremlinResourcesClient := documentdb.NewGremlinResourcesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	GremlinResourcesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+
+	databases, err := GremlinResourcesClient.ListGremlinDatabases(ctx, resourceGroupName, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if databases.Value == nil {
+		return resources, nil
+	}
+	for _, database := range *databases.Value {
+		databaseName := accountName + "_" + *database.Name
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*database.ID,
+			databaseName,
+			"azurerm_cosmosdb_gremlin_database",
+			g.ProviderName,
+			[]string{}))
+
+		graphs, err := GremlinResourcesClient.ListGremlinGraphs(ctx, resourceGroupName, accountName, *database.Name)
+		if err != nil {
+			return nil, err
+		}
+		if graphs.Value == nil {
+			continue
+		}
+		for _, graph := range *graphs.Value {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*graph.ID,
+				databaseName+"_"+*graph.Name,
+				"azurerm_cosmosdb_gremlin_graph",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+
+	return resources, nil
+}
+
+// cosmosDBAccountAPI returns the API exposed by the account: mongo, cassandra, gremlin, table or sql
+func cosmosDBAccountAPI(account documentdb.DatabaseAccountGetResults) string {
+	if account.Kind == documentdb.DatabaseAccountKindMongoDB {
+		return "mongo"
+	}
+	if account.DatabaseAccountGetProperties != nil && account.Capabilities != nil {
+		for _, capability := range *account.Capabilities {
+			if capability.Name == nil {
+				continue
+			}
+			switch *capability.Name {
+			case "EnableCassandra":
+				return "cassandra"
+			case "EnableGremlin":
+				return "gremlin"
+			case "EnableTable":
+				return "table"
+			}
+		}
+	}
+	return "sql"
+}
+
 func (g *CosmosDBGenerator) listTables(resourceGroupName string, accountName string) ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	ctx := context.Background()
@@ -135,23 +408,45 @@ func (g *CosmosDBGenerator) listAndAddForDatabaseAccounts() ([]terraformutils.Re
 			return nil, err
 		}
 
-		tables, err := g.listTables(id.ResourceGroup, *account.Name)
-		if err != nil {
-			return nil, err
+		// each account exposes a single API, listing the resources of the others fails
+		var listFunctions []func(string, string) ([]terraformutils.Resource, error)
+		switch cosmosDBAccountAPI(account) {
+		case "mongo":
+			listFunctions = append(listFunctions, g.listMongoDatabasesAndCollections)
+		case "cassandra":
+			listFunctions = append(listFunctions, g.listCassandraKeyspacesAndTables)
+		case "gremlin":
+			listFunctions = append(listFunctions, g.listGremlinDatabasesAndGraphs)
+		case "table":
+			listFunctions = append(listFunctions, g.listTables)
+		default:
+			listFunctions = append(listFunctions, g.listSQLResources, g.listSQLRoles)
 		}
-		resources = append(resources, tables...)
-
-		sqlDatabases, sqlContainers, err := g.listSQLDatabasesAndContainersBehind(id.ResourceGroup, *account.Name)
-		if err != nil {
-			return nil, err
+		for _, f := range listFunctions {
+			accountResources, err := f(id.ResourceGroup, *account.Name)
+			if err != nil {
+				log.Printf("can't list the resources of account %s: %v", *account.Name, err)
+				continue
+			}
+			resources = append(resources, accountResources...)
 		}
-		resources = append(resources, sqlDatabases...)
-		resources = append(resources, sqlContainers...)
 	}
 
 	return resources, nil
 }
 
+// PostConvertHook keeps the autoscale settings of databases, containers, collections, tables
+// and graphs using autoscale, the throughput Azure returns for them is the current one
+func (g *CosmosDBGenerator) PostConvertHook() error {
+	for i, r := range g.Resources {
+		if r.InstanceState.Attributes["autoscale_settings.#"] == "1" {
+			delete(g.Resources[i].Item, "throughput")
+		}
+	}
+	g.linkResourceIDs("role_definition_id")
+	return nil
+}
+
 func (g *CosmosDBGenerator) InitResources() error {
 	functions := []func() ([]terraformutils.Resource, error){
 		g.listAndAddForDatabaseAccounts,
@@ -17,12 +17,15 @@ package azure
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2021-05-01/mysqlflexibleservers"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
-	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql"
+	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2021-06-01/postgresqlflexibleservers"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/hashicorp/go-azure-helpers/authentication"
@@ -32,6 +35,12 @@ type DatabasesGenerator struct {
 	AzureService
 }
 
+// databases created and managed by the flexible server itself
+var (
+	postgreSQLSystemDatabases = map[string]bool{"azure_maintenance": true, "azure_sys": true}
+	mySQLSystemDatabases      = map[string]bool{"information_schema": true, "mysql": true, "performance_schema": true, "sys": true}
+)
+
 func (g *DatabasesGenerator) getMariaDBServers() ([]mariadb.Server, error) {
 	ctx := context.Background()
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
@@ -570,47 +579,46 @@ func (g *DatabasesGenerator) createPostgreSQLVirtualNetworkRuleResources(servers
 	return resources, nil
 }
 
-func (g *DatabasesGenerator) getSQLServers() ([]sql.Server, error) {
+func (g *DatabasesGenerator) getMSSQLServers() ([]sql.Server, error) {
 	var servers []sql.Server
 	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	Authorizer := g.Args["authorizer"].(autorest.Authorizer)
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
 
 	Client := sql.NewServersClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
-	Client.Authorizer = Authorizer
-
-	var (
-		ServerPages sql.ServerListResultPage
-		err         error
-	)
-
-	if rg := g.Args["resource_group"].(string); rg != "" {
-		ServerPages, err = Client.ListByResourceGroup(ctx, rg)
-	} else {
-		ServerPages, err = Client.List(ctx)
-	}
-	if err != nil {
-		return nil, err
-	}
-	for ServerPages.NotDone() {
-		servers = append(servers, ServerPages.Values()...)
-		if err := ServerPages.NextWithContext(ctx); err != nil {
+	Client.Authorizer = authorizer
+
+	for _, rgName := range g.resourceGroups() {
+		var (
+			iterator sql.ServerListResultIterator
+			err      error
+		)
+		if rgName != "" {
+			iterator, err = Client.ListByResourceGroupComplete(ctx, rgName, "")
+		} else {
+			iterator, err = Client.ListComplete(ctx, "")
+		}
+		if err != nil {
 			return nil, err
 		}
+		for iterator.NotDone() {
+			servers = append(servers, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return servers, nil
 }
 
-func (g *DatabasesGenerator) createSQLServerResources(servers []sql.Server) ([]terraformutils.Resource, error) {
+func (g *DatabasesGenerator) createMSSQLServerResources(servers []sql.Server) ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 
 	for _, server := range servers {
 		resources = append(resources, terraformutils.NewResource(
 			*server.ID,
 			*server.Name,
-			"azurerm_sql_server",
+			"azurerm_mssql_server",
 			g.ProviderName,
 			map[string]string{},
 			[]string{},
@@ -622,79 +630,98 @@ func (g *DatabasesGenerator) createSQLServerResources(servers []sql.Server) ([]t
 	return resources, nil
 }
 
-func (g *DatabasesGenerator) createSQLDatabaseResources(servers []sql.Server) ([]terraformutils.Resource, error) {
+func (g *DatabasesGenerator) createMSSQLDatabaseResources(servers []sql.Server) ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	Authorizer := g.Args["authorizer"].(autorest.Authorizer)
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
 
 	Client := sql.NewDatabasesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
-	Client.Authorizer = Authorizer
+	Client.Authorizer = authorizer
+	AuditingClient := sql.NewExtendedDatabaseBlobAuditingPoliciesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	AuditingClient.Authorizer = authorizer
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
 		if err != nil {
 			return nil, err
 		}
-		databases, err := Client.ListByServer(ctx, id.ResourceGroup, *server.Name, "", "")
+		iterator, err := Client.ListByServerComplete(ctx, id.ResourceGroup, *server.Name, "")
 		if err != nil {
 			return nil, err
 		}
 
-		for _, database := range *databases.Value {
-			resources = append(resources, terraformutils.NewSimpleResource(
-				*database.ID,
-				*database.Name+"-"+*server.Name,
-				"azurerm_sql_database",
-				g.ProviderName,
-				[]string{}))
+		for iterator.NotDone() {
+			database := iterator.Value()
+			// master is created with the server
+			if *database.Name != "master" {
+				resources = append(resources, terraformutils.NewSimpleResource(
+					*database.ID,
+					*database.Name+"-"+*server.Name,
+					"azurerm_mssql_database",
+					g.ProviderName,
+					[]string{}))
+
+				policy, err := AuditingClient.Get(ctx, id.ResourceGroup, *server.Name, *database.Name)
+				if err != nil {
+					log.Println(err)
+				} else if policy.ExtendedDatabaseBlobAuditingPolicyProperties != nil && policy.State == sql.BlobAuditingPolicyStateEnabled {
+					resources = append(resources, terraformutils.NewSimpleResource(
+						*database.ID+"/extendedAuditingSettings/default",
+						*database.Name+"-"+*server.Name,
+						"azurerm_mssql_database_extended_auditing_policy",
+						g.ProviderName,
+						[]string{}))
+				}
+			}
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
 		}
 	}
 	return resources, nil
 }
 
-func (g *DatabasesGenerator) createSQLFirewallRuleResources(servers []sql.Server) ([]terraformutils.Resource, error) {
+func (g *DatabasesGenerator) createMSSQLFirewallRuleResources(servers []sql.Server) ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	Authorizer := g.Args["authorizer"].(autorest.Authorizer)
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
 
 	Client := sql.NewFirewallRulesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
-	Client.Authorizer = Authorizer
+	Client.Authorizer = authorizer
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
 		if err != nil {
 			return nil, err
 		}
-		rules, err := Client.ListByServer(ctx, id.ResourceGroup, *server.Name)
+		iterator, err := Client.ListByServerComplete(ctx, id.ResourceGroup, *server.Name)
 		if err != nil {
 			return nil, err
 		}
 
-		for _, rule := range *rules.Value {
+		for iterator.NotDone() {
+			rule := iterator.Value()
 			resources = append(resources, terraformutils.NewSimpleResource(
 				*rule.ID,
-				*rule.Name,
-				"azurerm_sql_firewall_rule",
+				*rule.Name+"-"+*server.Name,
+				"azurerm_mssql_firewall_rule",
 				g.ProviderName,
 				[]string{}))
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
 		}
 	}
 	return resources, nil
 }
 
-func (g *DatabasesGenerator) createSQLVirtualNetworkRuleResources(servers []sql.Server) ([]terraformutils.Resource, error) {
+func (g *DatabasesGenerator) createMSSQLVirtualNetworkRuleResources(servers []sql.Server) ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	Authorizer := g.Args["authorizer"].(autorest.Authorizer)
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
 
 	Client := sql.NewVirtualNetworkRulesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
-	Client.Authorizer = Authorizer
+	Client.Authorizer = authorizer
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -710,8 +737,8 @@ func (g *DatabasesGenerator) createSQLVirtualNetworkRuleResources(servers []sql.
 			rule := ruleIter.Value()
 			resources = append(resources, terraformutils.NewSimpleResource(
 				*rule.ID,
-				*rule.Name,
-				"azurerm_sql_virtual_network_rule",
+				*rule.Name+"-"+*server.Name,
+				"azurerm_mssql_virtual_network_rule",
 				g.ProviderName,
 				[]string{}))
 
@@ -723,47 +750,47 @@ func (g *DatabasesGenerator) createSQLVirtualNetworkRuleResources(servers []sql.
 	return resources, nil
 }
 
-func (g *DatabasesGenerator) createSQLElasticPoolResources(servers []sql.Server) ([]terraformutils.Resource, error) {
+func (g *DatabasesGenerator) createMSSQLElasticPoolResources(servers []sql.Server) ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	Authorizer := g.Args["authorizer"].(autorest.Authorizer)
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
 
 	Client := sql.NewElasticPoolsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
-	Client.Authorizer = Authorizer
+	Client.Authorizer = authorizer
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
 		if err != nil {
 			return nil, err
 		}
-		pools, err := Client.ListByServer(ctx, id.ResourceGroup, *server.Name)
+		iterator, err := Client.ListByServerComplete(ctx, id.ResourceGroup, *server.Name, nil)
 		if err != nil {
 			return nil, err
 		}
 
-		for _, pool := range *pools.Value {
+		for iterator.NotDone() {
+			pool := iterator.Value()
 			resources = append(resources, terraformutils.NewSimpleResource(
 				*pool.ID,
-				*pool.Name,
-				"azurerm_sql_elasticpool",
+				*pool.Name+"-"+*server.Name,
+				"azurerm_mssql_elasticpool",
 				g.ProviderName,
 				[]string{}))
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
 		}
 	}
 	return resources, nil
 }
 
-func (g *DatabasesGenerator) createSQLFailoverResources(servers []sql.Server) ([]terraformutils.Resource, error) {
+func (g *DatabasesGenerator) createMSSQLFailoverResources(servers []sql.Server) ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	Authorizer := g.Args["authorizer"].(autorest.Authorizer)
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
 
 	Client := sql.NewFailoverGroupsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
-	Client.Authorizer = Authorizer
+	Client.Authorizer = authorizer
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -782,7 +809,7 @@ func (g *DatabasesGenerator) createSQLFailoverResources(servers []sql.Server) ([
 			resources = append(resources, terraformutils.NewSimpleResource(
 				*failoverGroup.ID,
 				*failoverGroup.Name,
-				"azurerm_sql_failover_group",
+				"azurerm_mssql_failover_group",
 				g.ProviderName,
 				[]string{}))
 
@@ -794,15 +821,282 @@ func (g *DatabasesGenerator) createSQLFailoverResources(servers []sql.Server) ([
 	return resources, nil
 }
 
-func (g *DatabasesGenerator) createSQLADAdministratorResources(servers []sql.Server) ([]terraformutils.Resource, error) {
+// createMSSQLSecurityResources imports the auditing, TDE, threat detection and vulnerability
+// assessment settings of each server, settings left at their defaults are skipped
+func (g *DatabasesGenerator) createMSSQLSecurityResources(servers []sql.Server) ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	Authorizer := g.Args["authorizer"].(autorest.Authorizer)
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
 
-	Client := sql.NewServerAzureADAdministratorsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
-	Client.Authorizer = Authorizer
+	AuditingClient := sql.NewExtendedServerBlobAuditingPoliciesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	AuditingClient.Authorizer = authorizer
+	EncryptionClient := sql.NewEncryptionProtectorsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	EncryptionClient.Authorizer = authorizer
+	AlertPolicyClient := sql.NewServerSecurityAlertPoliciesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	AlertPolicyClient.Authorizer = authorizer
+	AssessmentClient := sql.NewServerVulnerabilityAssessmentsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	AssessmentClient.Authorizer = authorizer
+
+	for _, server := range servers {
+		id, err := ParseAzureResourceID(*server.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		auditing, err := AuditingClient.Get(ctx, id.ResourceGroup, *server.Name)
+		if err != nil {
+			log.Println(err)
+		} else if auditing.ExtendedServerBlobAuditingPolicyProperties != nil && auditing.State == sql.BlobAuditingPolicyStateEnabled {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*server.ID+"/extendedAuditingSettings/default",
+				*server.Name,
+				"azurerm_mssql_server_extended_auditing_policy",
+				g.ProviderName,
+				[]string{}))
+		}
+
+		if _, err := EncryptionClient.Get(ctx, id.ResourceGroup, *server.Name); err != nil {
+			log.Println(err)
+		} else {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*server.ID+"/encryptionProtector/current",
+				*server.Name,
+				"azurerm_mssql_server_transparent_data_encryption",
+				g.ProviderName,
+				[]string{}))
+		}
+
+		alertPolicy, err := AlertPolicyClient.Get(ctx, id.ResourceGroup, *server.Name)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if alertPolicy.SecurityAlertsPolicyProperties == nil || alertPolicy.State != sql.SecurityAlertsPolicyStateEnabled {
+			continue
+		}
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*server.ID+"/securityAlertPolicies/Default",
+			*server.Name,
+			"azurerm_mssql_server_security_alert_policy",
+			g.ProviderName,
+			[]string{}))
+
+		assessment, err := AssessmentClient.Get(ctx, id.ResourceGroup, *server.Name)
+		if err != nil {
+			log.Println(err)
+		} else if assessment.ServerVulnerabilityAssessmentProperties != nil && assessment.StorageContainerPath != nil && *assessment.StorageContainerPath != "" {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*server.ID+"/vulnerabilityAssessments/Default",
+				*server.Name,
+				"azurerm_mssql_server_vulnerability_assessment",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+	return resources, nil
+}
+
+func (g *DatabasesGenerator) getPostgreSQLFlexibleServers() ([]postgresqlflexibleservers.Server, error) {
+	var servers []postgresqlflexibleservers.Server
+	ctx := context.Background()
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
+
+	Client := postgresqlflexibleservers.NewServersClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	Client.Authorizer = authorizer
+
+	for _, rgName := range g.resourceGroups() {
+		var (
+			iterator postgresqlflexibleservers.ServerListResultIterator
+			err      error
+		)
+		if rgName != "" {
+			iterator, err = Client.ListByResourceGroupComplete(ctx, rgName)
+		} else {
+			iterator, err = Client.ListComplete(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for iterator.NotDone() {
+			servers = append(servers, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return servers, nil
+}
+
+func (g *DatabasesGenerator) createPostgreSQLFlexibleServerResources(servers []postgresqlflexibleservers.Server) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+
+	for _, server := range servers {
+		resources = append(resources, terraformutils.NewResource(
+			*server.ID,
+			*server.Name,
+			"azurerm_postgresql_flexible_server",
+			g.ProviderName,
+			map[string]string{},
+			[]string{},
+			map[string]interface{}{
+				"administrator_password": "",
+			}))
+	}
+
+	return resources, nil
+}
+
+func (g *DatabasesGenerator) createPostgreSQLFlexibleServerChildResources(servers []postgresqlflexibleservers.Server) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
+
+	DatabasesClient := postgresqlflexibleservers.NewDatabasesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	DatabasesClient.Authorizer = authorizer
+	ConfigurationsClient := postgresqlflexibleservers.NewConfigurationsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	ConfigurationsClient.Authorizer = authorizer
+	FirewallRulesClient := postgresqlflexibleservers.NewFirewallRulesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	FirewallRulesClient.Authorizer = authorizer
+
+	for _, server := range servers {
+		id, err := ParseAzureResourceID(*server.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		databases, err := DatabasesClient.ListByServerComplete(ctx, id.ResourceGroup, *server.Name)
+		if err != nil {
+			return nil, err
+		}
+		for databases.NotDone() {
+			database := databases.Value()
+			if !postgreSQLSystemDatabases[*database.Name] {
+				resources = append(resources, terraformutils.NewSimpleResource(
+					*database.ID,
+					*database.Name+"-"+*server.Name,
+					"azurerm_postgresql_flexible_server_database",
+					g.ProviderName,
+					[]string{}))
+			}
+			if err := databases.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		configurations, err := ConfigurationsClient.ListByServerComplete(ctx, id.ResourceGroup, *server.Name)
+		if err != nil {
+			return nil, err
+		}
+		for configurations.NotDone() {
+			config := configurations.Value()
+			// only parameters changed from their default
+			if config.ConfigurationProperties != nil && config.Source != nil && *config.Source == "user-override" {
+				resources = append(resources, terraformutils.NewSimpleResource(
+					*config.ID,
+					*config.Name+"-"+*server.Name,
+					"azurerm_postgresql_flexible_server_configuration",
+					g.ProviderName,
+					[]string{}))
+			}
+			if err := configurations.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		rules, err := FirewallRulesClient.ListByServerComplete(ctx, id.ResourceGroup, *server.Name)
+		if err != nil {
+			return nil, err
+		}
+		for rules.NotDone() {
+			rule := rules.Value()
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*rule.ID,
+				*rule.Name+"-"+*server.Name,
+				"azurerm_postgresql_flexible_server_firewall_rule",
+				g.ProviderName,
+				[]string{}))
+			if err := rules.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		administrators, err := g.listARMResources(*server.ID+"/administrators", "2022-12-01")
+		if err != nil {
+			log.Println(err)
+		}
+		for _, administrator := range administrators {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				administrator.ID,
+				*server.Name+"-"+administrator.Name,
+				"azurerm_postgresql_flexible_server_active_directory_administrator",
+				g.ProviderName,
+				[]string{}))
+		}
+	}
+	return resources, nil
+}
+
+func (g *DatabasesGenerator) getMySQLFlexibleServers() ([]mysqlflexibleservers.Server, error) {
+	var servers []mysqlflexibleservers.Server
+	ctx := context.Background()
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
+
+	Client := mysqlflexibleservers.NewServersClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	Client.Authorizer = authorizer
+
+	for _, rgName := range g.resourceGroups() {
+		var (
+			iterator mysqlflexibleservers.ServerListResultIterator
+			err      error
+		)
+		if rgName != "" {
+			iterator, err = Client.ListByResourceGroupComplete(ctx, rgName)
+		} else {
+			iterator, err = Client.ListComplete(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for iterator.NotDone() {
+			servers = append(servers, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return servers, nil
+}
+
+func (g *DatabasesGenerator) createMySQLFlexibleServerResources(servers []mysqlflexibleservers.Server) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+
+	for _, server := range servers {
+		resources = append(resources, terraformutils.NewResource(
+			*server.ID,
+			*server.Name,
+			"azurerm_mysql_flexible_server",
+			g.ProviderName,
+			map[string]string{},
+			[]string{},
+			map[string]interface{}{
+				"administrator_password": "",
+			}))
+	}
+
+	return resources, nil
+}
+
+func (g *DatabasesGenerator) createMySQLFlexibleServerChildResources(servers []mysqlflexibleservers.Server) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
+
+	DatabasesClient := mysqlflexibleservers.NewDatabasesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	DatabasesClient.Authorizer = authorizer
+	ConfigurationsClient := mysqlflexibleservers.NewConfigurationsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	ConfigurationsClient.Authorizer = authorizer
+	FirewallRulesClient := mysqlflexibleservers.NewFirewallRulesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	FirewallRulesClient.Authorizer = authorizer
 
 	for _, server := range servers {
 		id, err := ParseAzureResourceID(*server.ID)
@@ -810,16 +1104,71 @@ func (g *DatabasesGenerator) createSQLADAdministratorResources(servers []sql.Ser
 			return nil, err
 		}
 
-		administrators, err := Client.ListByServer(ctx, id.ResourceGroup, *server.Name)
+		databases, err := DatabasesClient.ListByServerComplete(ctx, id.ResourceGroup, *server.Name)
+		if err != nil {
+			return nil, err
+		}
+		for databases.NotDone() {
+			database := databases.Value()
+			if !mySQLSystemDatabases[*database.Name] {
+				resources = append(resources, terraformutils.NewSimpleResource(
+					*database.ID,
+					*database.Name+"-"+*server.Name,
+					"azurerm_mysql_flexible_database",
+					g.ProviderName,
+					[]string{}))
+			}
+			if err := databases.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		configurations, err := ConfigurationsClient.ListByServerComplete(ctx, id.ResourceGroup, *server.Name)
+		if err != nil {
+			return nil, err
+		}
+		for configurations.NotDone() {
+			config := configurations.Value()
+			// only parameters changed from their default
+			if config.ConfigurationProperties != nil && config.Source == mysqlflexibleservers.ConfigurationSourceUserOverride {
+				resources = append(resources, terraformutils.NewSimpleResource(
+					*config.ID,
+					*config.Name+"-"+*server.Name,
+					"azurerm_mysql_flexible_server_configuration",
+					g.ProviderName,
+					[]string{}))
+			}
+			if err := configurations.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		rules, err := FirewallRulesClient.ListByServerComplete(ctx, id.ResourceGroup, *server.Name)
 		if err != nil {
 			return nil, err
 		}
+		for rules.NotDone() {
+			rule := rules.Value()
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*rule.ID,
+				*rule.Name+"-"+*server.Name,
+				"azurerm_mysql_flexible_server_firewall_rule",
+				g.ProviderName,
+				[]string{}))
+			if err := rules.NextWithContext(ctx); err != nil {
+				return nil, err
+			}
+		}
 
-		for _, administrator := range *administrators.Value {
+		administrators, err := g.listARMResources(*server.ID+"/administrators", "2021-12-01-preview")
+		if err != nil {
+			log.Println(err)
+		}
+		for _, administrator := range administrators {
 			resources = append(resources, terraformutils.NewSimpleResource(
-				*administrator.ID,
-				*administrator.Name,
-				"azurerm_sql_active_directory_administrator",
+				administrator.ID,
+				*server.Name+"-"+administrator.Name,
+				"azurerm_mysql_flexible_server_active_directory_administrator",
 				g.ProviderName,
 				[]string{}))
 		}
@@ -843,9 +1192,20 @@ func (g *DatabasesGenerator) InitResources() error {
 		return err
 	}
 
-	sqlServers, err := g.getSQLServers()
+	// engines added later are skipped when their resource provider can't be listed
+	mssqlServers, err := g.getMSSQLServers()
 	if err != nil {
-		return err
+		log.Printf("can't list SQL servers: %v", err)
+	}
+
+	postgresqlFlexibleServers, err := g.getPostgreSQLFlexibleServers()
+	if err != nil {
+		log.Printf("can't list PostgreSQL flexible servers: %v", err)
+	}
+
+	mysqlFlexibleServers, err := g.getMySQLFlexibleServers()
+	if err != nil {
+		log.Printf("can't list MySQL flexible servers: %v", err)
 	}
 
 	mariadbFunctions := []func([]mariadb.Server) ([]terraformutils.Resource, error){
@@ -872,14 +1232,24 @@ func (g *DatabasesGenerator) InitResources() error {
 		g.createPostgreSQLVirtualNetworkRuleResources,
 	}
 
-	sqlFunctions := []func([]sql.Server) ([]terraformutils.Resource, error){
-		g.createSQLServerResources,
-		g.createSQLDatabaseResources,
-		g.createSQLADAdministratorResources,
-		g.createSQLElasticPoolResources,
-		g.createSQLFailoverResources,
-		g.createSQLFirewallRuleResources,
-		g.createSQLVirtualNetworkRuleResources,
+	mssqlFunctions := []func([]sql.Server) ([]terraformutils.Resource, error){
+		g.createMSSQLServerResources,
+		g.createMSSQLDatabaseResources,
+		g.createMSSQLElasticPoolResources,
+		g.createMSSQLFailoverResources,
+		g.createMSSQLFirewallRuleResources,
+		g.createMSSQLVirtualNetworkRuleResources,
+		g.createMSSQLSecurityResources,
+	}
+
+	postgresqlFlexibleFunctions := []func([]postgresqlflexibleservers.Server) ([]terraformutils.Resource, error){
+		g.createPostgreSQLFlexibleServerResources,
+		g.createPostgreSQLFlexibleServerChildResources,
+	}
+
+	mysqlFlexibleFunctions := []func([]mysqlflexibleservers.Server) ([]terraformutils.Resource, error){
+		g.createMySQLFlexibleServerResources,
+		g.createMySQLFlexibleServerChildResources,
 	}
 
 	for _, f := range mariadbFunctions {
@@ -906,10 +1276,29 @@ func (g *DatabasesGenerator) InitResources() error {
 		g.Resources = append(g.Resources, resources...)
 	}
 
-	for _, f := range sqlFunctions {
-		resources, err := f(sqlServers)
+	for _, f := range mssqlFunctions {
+		resources, err := f(mssqlServers)
 		if err != nil {
-			return err
+			log.Printf("can't list SQL server resources: %v", err)
+			continue
+		}
+		g.Resources = append(g.Resources, resources...)
+	}
+
+	for _, f := range postgresqlFlexibleFunctions {
+		resources, err := f(postgresqlFlexibleServers)
+		if err != nil {
+			log.Printf("can't list PostgreSQL flexible server resources: %v", err)
+			continue
+		}
+		g.Resources = append(g.Resources, resources...)
+	}
+
+	for _, f := range mysqlFlexibleFunctions {
+		resources, err := f(mysqlFlexibleServers)
+		if err != nil {
+			log.Printf("can't list MySQL flexible server resources: %v", err)
+			continue
 		}
 		g.Resources = append(g.Resources, resources...)
 	}
@@ -917,29 +1306,38 @@ func (g *DatabasesGenerator) InitResources() error {
 	return nil
 }
 
+// dbEngine returns the engine a database resource type belongs to, flexible servers
+// being a separate engine, e.g. "mysql_flexible" for azurerm_mysql_flexible_database
+func dbEngine(resourceType string) string {
+	parts := strings.Split(strings.TrimPrefix(resourceType, "azurerm_"), "_")
+	if len(parts) > 1 && parts[1] == "flexible" {
+		return parts[0] + "_flexible"
+	}
+	return parts[0]
+}
+
+// PostConvertHook references the servers imported in this service from their databases,
+// rules and settings, by server_name for single servers and by ID for the others
 func (g *DatabasesGenerator) PostConvertHook() error {
-	dbEngines := []string{
-		"mariadb",
-		"mysql",
-		"postgresql",
-		"sql",
-	}
-
-	for _, engineName := range dbEngines {
-		for _, resource := range g.Resources {
-			dbServerResourceType := fmt.Sprintf("azurerm_%s_server", engineName)
-			if resource.InstanceInfo.Type == dbServerResourceType {
-				dbName := resource.Item["name"]
-				for rIdx, r := range g.Resources {
-					if r.InstanceInfo.Type != dbServerResourceType &&
-						strings.Contains(r.InstanceInfo.Type, engineName) &&
-						r.Item["server_name"] == dbName {
-						g.Resources[rIdx].Item["server_name"] = fmt.Sprintf("${%s.%s}", resource.InstanceInfo.Id, "name")
-					}
-				}
+	for _, server := range g.Resources {
+		if !strings.HasSuffix(server.InstanceInfo.Type, "_server") {
+			continue
+		}
+		engine := dbEngine(server.InstanceInfo.Type)
+		for rIdx, r := range g.Resources {
+			if r.InstanceInfo.Type == server.InstanceInfo.Type || dbEngine(r.InstanceInfo.Type) != engine {
+				continue
+			}
+			if r.Item["server_name"] != server.Item["name"] {
+				continue
+			}
+			if rg, ok := r.Item["resource_group_name"]; ok && rg != server.Item["resource_group_name"] {
+				continue
 			}
+			g.Resources[rIdx].Item["server_name"] = fmt.Sprintf("${%s.%s}", server.InstanceInfo.Id, "name")
 		}
 	}
+	g.linkResourceIDs("server_id", "database_id", "elastic_pool_id", "server_security_alert_policy_id")
 
 	return nil
 }
@@ -0,0 +1,64 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+)
+
+type DdosProtectionPlanGenerator struct {
+	AzureService
+}
+
+func (az *DdosProtectionPlanGenerator) appendDdosProtectionPlans(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := network.NewDdosProtectionPlansClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator network.DdosProtectionPlanListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListComplete(ctx)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, *item.Name, "azurerm_network_ddos_protection_plan")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *DdosProtectionPlanGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendDdosProtectionPlans(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
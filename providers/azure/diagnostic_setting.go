@@ -0,0 +1,68 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// DiagnosticSettingGenerator imports the diagnostic settings of the resources
+// imported by the other services of the same run
+type DiagnosticSettingGenerator struct {
+	AzureService
+	importedResources []terraformutils.Resource
+}
+
+func (az *DiagnosticSettingGenerator) SetImportedResources(resources []terraformutils.Resource) {
+	az.importedResources = resources
+}
+
+func (az *DiagnosticSettingGenerator) InitResources() error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := insights.NewDiagnosticSettingsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	seen := map[string]bool{}
+	for _, resource := range az.importedResources {
+		resourceID := resource.InstanceState.ID
+		// skip composite import IDs like "<role definition ID>|<scope>"
+		if !strings.HasPrefix(resourceID, "/") || strings.Contains(resourceID, "|") || seen[strings.ToLower(resourceID)] {
+			continue
+		}
+		seen[strings.ToLower(resourceID)] = true
+		result, err := client.List(ctx, resourceID)
+		if err != nil {
+			// most child resources don't support diagnostic settings
+			if az.Verbose {
+				log.Println(err)
+			}
+			continue
+		}
+		if result.Value == nil {
+			continue
+		}
+		for _, setting := range *result.Value {
+			// the provider imports diagnostic settings as "<resource ID>|<name>"
+			az.AppendSimpleResource(resourceID+"|"+*setting.Name, resource.ResourceName+"_"+*setting.Name, "azurerm_monitor_diagnostic_setting")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/eventgrid/mgmt/2021-12-01/eventgrid"
+)
+
+type EventGridGenerator struct {
+	AzureService
+}
+
+func (az *EventGridGenerator) appendEventSubscriptions(iterator eventgrid.EventSubscriptionsListResultIterator, parentName, resourceType string) error {
+	ctx := context.Background()
+	for iterator.NotDone() {
+		item := iterator.Value()
+		name := *item.Name
+		if parentName != "" {
+			name = parentName + "_" + name
+		}
+		az.AppendSimpleResource(*item.ID, name, resourceType)
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *EventGridGenerator) appendTopics(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := eventgrid.NewTopicsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	subscriptionsClient := eventgrid.NewEventSubscriptionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	subscriptionsClient.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator eventgrid.TopicsListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionComplete(ctx, "", nil)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName, "", nil)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		topic := iterator.Value()
+		az.AppendSimpleResource(*topic.ID, *topic.Name, "azurerm_eventgrid_topic")
+		id, err := ParseAzureResourceID(*topic.ID)
+		if err != nil {
+			return err
+		}
+		subscriptions, err := subscriptionsClient.ListByResourceComplete(ctx, id.ResourceGroup, "Microsoft.EventGrid", "topics", *topic.Name, "", nil)
+		if err != nil {
+			return err
+		}
+		if err := az.appendEventSubscriptions(subscriptions, *topic.Name, "azurerm_eventgrid_event_subscription"); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *EventGridGenerator) appendDomains(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := eventgrid.NewDomainsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	subscriptionsClient := eventgrid.NewEventSubscriptionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	subscriptionsClient.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator eventgrid.DomainsListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionComplete(ctx, "", nil)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName, "", nil)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		domain := iterator.Value()
+		az.AppendSimpleResource(*domain.ID, *domain.Name, "azurerm_eventgrid_domain")
+		id, err := ParseAzureResourceID(*domain.ID)
+		if err != nil {
+			return err
+		}
+		subscriptions, err := subscriptionsClient.ListByResourceComplete(ctx, id.ResourceGroup, "Microsoft.EventGrid", "domains", *domain.Name, "", nil)
+		if err != nil {
+			return err
+		}
+		if err := az.appendEventSubscriptions(subscriptions, *domain.Name, "azurerm_eventgrid_event_subscription"); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *EventGridGenerator) appendSystemTopics(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := eventgrid.NewSystemTopicsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	subscriptionsClient := eventgrid.NewSystemTopicEventSubscriptionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	subscriptionsClient.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator eventgrid.SystemTopicsListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionComplete(ctx, "", nil)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName, "", nil)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		topic := iterator.Value()
+		az.AppendSimpleResource(*topic.ID, *topic.Name, "azurerm_eventgrid_system_topic")
+		id, err := ParseAzureResourceID(*topic.ID)
+		if err != nil {
+			return err
+		}
+		subscriptions, err := subscriptionsClient.ListBySystemTopicComplete(ctx, id.ResourceGroup, *topic.Name, "", nil)
+		if err != nil {
+			return err
+		}
+		if err := az.appendEventSubscriptions(subscriptions, *topic.Name, "azurerm_eventgrid_system_topic_event_subscription"); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// appendGlobalEventSubscriptions appends the event subscriptions to subscription
+// and resource group events
+func (az *EventGridGenerator) appendGlobalEventSubscriptions(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := eventgrid.NewEventSubscriptionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator eventgrid.EventSubscriptionsListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListGlobalBySubscriptionComplete(ctx, "", nil)
+	} else {
+		iterator, err = client.ListGlobalByResourceGroupComplete(ctx, rgName, "", nil)
+	}
+	if err != nil {
+		return err
+	}
+	return az.appendEventSubscriptions(iterator, "", "azurerm_eventgrid_event_subscription")
+}
+
+func (az *EventGridGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendTopics(rgName); err != nil {
+			return err
+		}
+		if err := az.appendDomains(rgName); err != nil {
+			return err
+		}
+		if err := az.appendSystemTopics(rgName); err != nil {
+			return err
+		}
+		if err := az.appendGlobalEventSubscriptions(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links event subscriptions to their imported topic or domain
+func (az *EventGridGenerator) PostConvertHook() error {
+	az.linkResourceIDs("scope")
+	return nil
+}
@@ -147,3 +147,35 @@ func (az *EventHubGenerator) InitResources() error {
 	}
 	return nil
 }
+
+// isDefaultNetworkRuleset reports whether a namespace network rule set is the one
+// Azure creates, allowing all traffic without any rule
+func isDefaultNetworkRuleset(ruleset map[string]interface{}) bool {
+	if ruleset["default_action"] != "Allow" || ruleset["trusted_service_access_enabled"] == true {
+		return false
+	}
+	for _, key := range []string{"ip_rule", "virtual_network_rule"} {
+		if rules, ok := ruleset[key].([]interface{}); ok && len(rules) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// PostConvertHook drops the default network rule set of namespaces, explicit rule
+// sets and capture settings are kept as part of the namespace and event hub
+func (az *EventHubGenerator) PostConvertHook() error {
+	for i, resource := range az.Resources {
+		if resource.InstanceInfo.Type != "azurerm_eventhub_namespace" {
+			continue
+		}
+		rulesets, ok := resource.Item["network_rulesets"].([]interface{})
+		if !ok || len(rulesets) != 1 {
+			continue
+		}
+		if ruleset, ok := rulesets[0].(map[string]interface{}); ok && isDefaultNetworkRuleset(ruleset) {
+			delete(az.Resources[i].Item, "network_rulesets")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+)
+
+type ExpressRouteGenerator struct {
+	AzureService
+}
+
+func (az *ExpressRouteGenerator) appendCircuitConnections(resourceGroup, circuitName, peeringName, parentName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := network.NewExpressRouteCircuitConnectionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, resourceGroup, circuitName, peeringName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, parentName+"_"+*item.Name, "azurerm_express_route_circuit_connection")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *ExpressRouteGenerator) appendPeerings(resourceGroup, circuitName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := network.NewExpressRouteCircuitPeeringsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, resourceGroup, circuitName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		peeringName := circuitName + "_" + *item.Name
+		az.AppendSimpleResource(*item.ID, peeringName, "azurerm_express_route_circuit_peering")
+		if err := az.appendCircuitConnections(resourceGroup, circuitName, *item.Name, peeringName); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *ExpressRouteGenerator) appendAuthorizations(resourceGroup, circuitName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, resourceGroup, circuitName)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, circuitName+"_"+*item.Name, "azurerm_express_route_circuit_authorization")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *ExpressRouteGenerator) appendCircuits(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := network.NewExpressRouteCircuitsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator network.ExpressRouteCircuitListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListAllComplete(ctx)
+	} else {
+		iterator, err = client.ListComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		circuit := iterator.Value()
+		az.AppendSimpleResource(*circuit.ID, *circuit.Name, "azurerm_express_route_circuit")
+		id, err := ParseAzureResourceID(*circuit.ID)
+		if err != nil {
+			return err
+		}
+		if err := az.appendPeerings(id.ResourceGroup, *circuit.Name); err != nil {
+			return err
+		}
+		if err := az.appendAuthorizations(id.ResourceGroup, *circuit.Name); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// appendGateways appends the ExpressRoute gateways of virtual hubs and their connections
+func (az *ExpressRouteGenerator) appendGateways(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := network.NewExpressRouteGatewaysClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	connectionsClient := network.NewExpressRouteConnectionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	connectionsClient.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		result network.ExpressRouteGatewayList
+		err    error
+	)
+	if rgName == "" {
+		result, err = client.ListBySubscription(ctx)
+	} else {
+		result, err = client.ListByResourceGroup(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	if result.Value == nil {
+		return nil
+	}
+	for _, gateway := range *result.Value {
+		az.AppendSimpleResource(*gateway.ID, *gateway.Name, "azurerm_express_route_gateway")
+		id, err := ParseAzureResourceID(*gateway.ID)
+		if err != nil {
+			return err
+		}
+		connections, err := connectionsClient.List(ctx, id.ResourceGroup, *gateway.Name)
+		if err != nil {
+			return err
+		}
+		if connections.Value == nil {
+			continue
+		}
+		for _, connection := range *connections.Value {
+			az.AppendSimpleResource(*connection.ID, *gateway.Name+"_"+*connection.Name, "azurerm_express_route_connection")
+		}
+	}
+	return nil
+}
+
+func (az *ExpressRouteGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendCircuits(rgName); err != nil {
+			return err
+		}
+		if err := az.appendGateways(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links connections to the imported gateways and peerings
+func (az *ExpressRouteGenerator) PostConvertHook() error {
+	az.linkResourceIDs("express_route_gateway_id", "express_route_circuit_peering_id", "peering_id", "peer_peering_id")
+	return nil
+}
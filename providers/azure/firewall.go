@@ -47,7 +47,7 @@ func (g *FirewallGenerator) createFirewallResources(ctx context.Context, rgName
 		iObj, _ := ParseAzureResourceID(*fw.ID)
 		resourceGroup := iObj.ResourceGroup
 
-		firewallID := fixFWRGChars(*fw.ID)
+		firewallID := *fw.ID
 		tferName := terraformutils.TfSanitize(*fw.Name)
 
 		// 1) 先把主资源 azurerm_firewall 放进列表
@@ -98,7 +98,7 @@ func (g *FirewallGenerator) createFirewallPolicyResources(ctx context.Context, r
 		iObj, _ := ParseAzureResourceID(*policy.ID)
 		resourceGroup := iObj.ResourceGroup
 
-		policyID := fixFWRGChars(*policy.ID)
+		policyID := *policy.ID
 		tferName := terraformutils.TfSanitize(*policy.Name)
 
 		// 1) 主资源 azurerm_firewall_policy
@@ -157,7 +157,7 @@ func (g *FirewallGenerator) createFirewallPolicyRCGResources(
 			continue
 		}
 
-		rcgID := fixFWRGChars(*rcg.ID)
+		rcgID := *rcg.ID
 		rcgName := terraformutils.TfSanitize(*rcg.Name)
 
 		// log.Default().Println("rcgName: ", rcgName)
@@ -288,7 +288,7 @@ func (g *FirewallGenerator) createFirewallResourcesAll(ctx context.Context) ([]t
 		iObj, _ := ParseAzureResourceID(*fw.ID)
 		resourceGroup := iObj.ResourceGroup
 
-		firewallID := fixFWRGChars(*fw.ID)
+		firewallID := *fw.ID
 		tferName := terraformutils.TfSanitize(*fw.Name)
 
 		// azurerm_firewall
@@ -383,7 +383,7 @@ func (g *FirewallGenerator) createFirewallPolicyResourcesAll(ctx context.Context
 		iObj, _ := ParseAzureResourceID(*policy.ID)
 		resourceGroup := iObj.ResourceGroup
 
-		policyID := fixFWRGChars(*policy.ID)
+		policyID := *policy.ID
 		tferName := terraformutils.TfSanitize(*policy.Name)
 
 		// azurerm_firewall_policy
@@ -415,7 +415,7 @@ func (g *FirewallGenerator) createFirewallPolicyResourcesAll(ctx context.Context
 				continue
 			}
 
-			rcgID := fixFWRGChars(*rcg.ID)
+			rcgID := *rcg.ID
 			rcgName := terraformutils.TfSanitize(*rcg.Name)
 
 			resources = append(resources, terraformutils.NewSimpleResource(
@@ -439,11 +439,3 @@ func (g *FirewallGenerator) createFirewallPolicyResourcesAll(ctx context.Context
 	}
 	return resources, nil
 }
-
-// fixFWRGChars 修正资源 ID 中大小写或其他字符串问题，避免后续处理时不一致
-func fixFWRGChars(id string) string {
-	// 将 /resourcegroups/ 替换为 /resourceGroups/
-	id = strings.Replace(id, "/resourcegroups/", "/resourceGroups/", -1)
-	// 如果有其他替换需求可以继续补充
-	return id
-}
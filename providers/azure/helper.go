@@ -19,6 +19,8 @@ import (
 	"math/rand"
 	"net/url"
 	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 )
 
 // FROM https://github.com/terraform-providers/terraform-provider-azurerm/blob/6e006ff4e5d1fb200a6b37eb2743ff0ec8b11e0d/azurerm/helpers/azure/resourceid.go#L24
@@ -122,3 +124,33 @@ func asHereDoc(json string) string {
 %s
 JSON`, json)
 }
+
+// extensionResourceScope returns the scope of an extension resource such as a lock or
+// policy assignment, e.g. the resource group ID for a lock on a resource group.
+// resourceType is the provider namespace and type, like "Microsoft.Authorization/locks".
+func extensionResourceScope(id string, resourceType string) string {
+	i := strings.LastIndex(strings.ToLower(id), strings.ToLower("/providers/"+resourceType+"/"))
+	if i < 0 {
+		return ""
+	}
+	return id[:i]
+}
+
+// lastIDSegment returns the name at the end of a resource ID
+func lastIDSegment(id string) string {
+	return id[strings.LastIndex(id, "/")+1:]
+}
+
+// writeDataFile adds the content to the files written in data/ next to the resource
+// and returns the file() call reading it back
+func writeDataFile(resource *terraformutils.Resource, fileName string, content []byte) interface{} {
+	if resource.DataFiles == nil {
+		resource.DataFiles = map[string][]byte{}
+	}
+	resource.DataFiles[fileName] = content
+	if resource.DataFileCalls == nil {
+		resource.DataFileCalls = map[string]bool{}
+	}
+	resource.DataFileCalls[fileName] = true
+	return fmt.Sprintf("file(\"data/%s\")", fileName)
+}
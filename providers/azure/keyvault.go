@@ -16,75 +16,328 @@ package azure
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
+	keyvaultdata "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	hsm "github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
-	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/secrets"
 )
 
 type KeyVaultGenerator struct {
 	AzureService
 }
 
-func (g KeyVaultGenerator) createResources(ctx context.Context, client keyvault.VaultsClient) ([]terraformutils.Resource, error) {
-	resourceListResultIterator, err := client.ListComplete(ctx, nil)
+// itemVersion is a version of a key, secret or certificate
+type itemVersion struct {
+	id      string
+	created time.Time
+}
+
+// newestVersion returns the ID of the current version, keys, secrets and
+// certificates are imported by their versioned ID
+func newestVersion(versions []itemVersion) string {
+	var newest itemVersion
+	for _, version := range versions {
+		if newest.id == "" || version.created.After(newest.created) {
+			newest = version
+		}
+	}
+	return newest.id
+}
+
+func createdTime(created *date.UnixTime) time.Time {
+	if created == nil {
+		return time.Time{}
+	}
+	return time.Time(*created)
+}
+
+func (az *KeyVaultGenerator) listVaults() ([]keyvault.Vault, error) {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := keyvault.NewVaultsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var iterators []keyvault.VaultListResultIterator
+	if resourceGroup != "" {
+		for _, rgName := range strings.Split(resourceGroup, ",") {
+			iterator, err := client.ListByResourceGroupComplete(ctx, strings.TrimSpace(rgName), nil)
+			if err != nil {
+				return nil, err
+			}
+			iterators = append(iterators, iterator)
+		}
+	} else {
+		iterator, err := client.ListBySubscriptionComplete(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		iterators = append(iterators, iterator)
+	}
+	var vaults []keyvault.Vault
+	for _, iterator := range iterators {
+		for iterator.NotDone() {
+			vaults = append(vaults, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return vaults, err
+			}
+		}
+	}
+	return vaults, nil
+}
+
+// appendAccessPolicies appends the access policies of vaults not using RBAC, imported by
+// "<vault ID>/objectId/<object ID>[/applicationId/<application ID>]"
+func (az *KeyVaultGenerator) appendAccessPolicies(vault keyvault.Vault) {
+	props := vault.Properties
+	if props.EnableRbacAuthorization != nil && *props.EnableRbacAuthorization || props.AccessPolicies == nil {
+		return
+	}
+	for _, policy := range *props.AccessPolicies {
+		id := *vault.ID + "/objectId/" + *policy.ObjectID
+		name := *vault.Name + "_" + *policy.ObjectID
+		if policy.ApplicationID != nil {
+			id += "/applicationId/" + policy.ApplicationID.String()
+			name += "_" + policy.ApplicationID.String()
+		}
+		az.AppendSimpleResource(id, name, "azurerm_key_vault_access_policy")
+	}
+}
+
+func (az *KeyVaultGenerator) appendKeys(client keyvaultdata.BaseClient, vaultURI, vaultName string) error {
+	ctx := context.Background()
+	iterator, err := client.GetKeysComplete(ctx, vaultURI, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		// keys backing certificates are managed by the certificate
+		if item.Managed == nil || !*item.Managed {
+			name := lastIDSegment(*item.Kid)
+			versions, err := client.GetKeyVersionsComplete(ctx, vaultURI, name, nil)
+			if err != nil {
+				return err
+			}
+			var keyVersions []itemVersion
+			for versions.NotDone() {
+				version := versions.Value()
+				var created *date.UnixTime
+				if version.Attributes != nil {
+					created = version.Attributes.Created
+				}
+				keyVersions = append(keyVersions, itemVersion{*version.Kid, createdTime(created)})
+				if err := versions.NextWithContext(ctx); err != nil {
+					return err
+				}
+			}
+			if id := newestVersion(keyVersions); id != "" {
+				az.AppendSimpleResource(id, vaultName+"_"+name, "azurerm_key_vault_key")
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// appendSecrets appends the secrets, listing versions only so their values are never read
+func (az *KeyVaultGenerator) appendSecrets(client keyvaultdata.BaseClient, vaultURI, vaultName string) error {
+	ctx := context.Background()
+	iterator, err := client.GetSecretsComplete(ctx, vaultURI, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		// secrets backing certificates are managed by the certificate
+		if item.Managed == nil || !*item.Managed {
+			name := lastIDSegment(*item.ID)
+			versions, err := client.GetSecretVersionsComplete(ctx, vaultURI, name, nil)
+			if err != nil {
+				return err
+			}
+			var secretVersions []itemVersion
+			for versions.NotDone() {
+				version := versions.Value()
+				var created *date.UnixTime
+				if version.Attributes != nil {
+					created = version.Attributes.Created
+				}
+				secretVersions = append(secretVersions, itemVersion{*version.ID, createdTime(created)})
+				if err := versions.NextWithContext(ctx); err != nil {
+					return err
+				}
+			}
+			if id := newestVersion(secretVersions); id != "" {
+				az.AppendSimpleResource(id, vaultName+"_"+name, "azurerm_key_vault_secret")
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *KeyVaultGenerator) appendCertificates(client keyvaultdata.BaseClient, vaultURI, vaultName string) error {
+	ctx := context.Background()
+	iterator, err := client.GetCertificatesComplete(ctx, vaultURI, nil, nil)
 	if err != nil {
-		return nil, err
-	}
-	var resources []terraformutils.Resource
-	for resourceListResultIterator.NotDone() {
-		vault := resourceListResultIterator.Value()
-		resources = append(resources, terraformutils.NewSimpleResource(
-			*vault.ID,
-			*vault.Name,
-			"azurerm_key_vault",
-			"azurerm",
-			[]string{}))
-		if err := resourceListResultIterator.NextWithContext(ctx); err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		name := lastIDSegment(*iterator.Value().ID)
+		versions, err := client.GetCertificateVersionsComplete(ctx, vaultURI, name, nil)
+		if err != nil {
+			return err
+		}
+		var certificateVersions []itemVersion
+		for versions.NotDone() {
+			version := versions.Value()
+			var created *date.UnixTime
+			if version.Attributes != nil {
+				created = version.Attributes.Created
+			}
+			certificateVersions = append(certificateVersions, itemVersion{*version.ID, createdTime(created)})
+			if err := versions.NextWithContext(ctx); err != nil {
+				return err
+			}
+		}
+		if id := newestVersion(certificateVersions); id != "" {
+			az.AppendSimpleResource(id, vaultName+"_"+name, "azurerm_key_vault_certificate")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
 			log.Println(err)
-			return resources, err
+			return err
 		}
 	}
-	return resources, nil
+	return nil
 }
 
-func (g KeyVaultGenerator) createResourcesByResourceGroup(ctx context.Context, rg string, client keyvault.VaultsClient) ([]terraformutils.Resource, error) {
-	iterator, err := client.ListByResourceGroupComplete(ctx, rg, nil)
+func (az *KeyVaultGenerator) appendCertificateIssuers(client keyvaultdata.BaseClient, vaultURI, vaultName string) error {
+	ctx := context.Background()
+	iterator, err := client.GetCertificateIssuersComplete(ctx, vaultURI, nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	var resources []terraformutils.Resource
 	for iterator.NotDone() {
-		vault := iterator.Value()
-		resources = append(resources, terraformutils.NewSimpleResource(
-			*vault.ID,
-			*vault.Name,
-			"azurerm_key_vault",
-			"azurerm",
-			[]string{}))
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, vaultName+"_"+lastIDSegment(*item.ID), "azurerm_key_vault_certificate_issuer")
 		if err := iterator.NextWithContext(ctx); err != nil {
 			log.Println(err)
-			return resources, err
+			return err
 		}
 	}
-	return resources, nil
+	return nil
+}
+
+// appendVaultContents lists the keys, secrets, certificates and issuers of the vault
+// with the data plane API, which is subject to the network rules of the vault
+func (az *KeyVaultGenerator) appendVaultContents(vault keyvault.Vault) error {
+	if vault.Properties.VaultURI == nil {
+		return fmt.Errorf("key vault %s has no URI", *vault.Name)
+	}
+	client := keyvaultdata.New()
+	client.Authorizer = az.Args["keyvault_authorizer"].(autorest.Authorizer)
+	vaultURI := strings.TrimSuffix(*vault.Properties.VaultURI, "/")
+
+	if err := az.appendKeys(client, vaultURI, *vault.Name); err != nil {
+		return err
+	}
+	if err := az.appendSecrets(client, vaultURI, *vault.Name); err != nil {
+		return err
+	}
+	if err := az.appendCertificates(client, vaultURI, *vault.Name); err != nil {
+		return err
+	}
+	return az.appendCertificateIssuers(client, vaultURI, *vault.Name)
 }
 
-func (g *KeyVaultGenerator) InitResources() error {
+func (az *KeyVaultGenerator) appendManagedHSMs(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := hsm.NewManagedHsmsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
 	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	vaultsClient := keyvault.NewVaultsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
 
-	vaultsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	var (
+		iterator hsm.ManagedHsmListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionComplete(ctx, nil)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName, nil)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, *item.Name, "azurerm_key_vault_managed_hardware_security_module")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
 
-	var err error
-	if rg := g.Args["resource_group"].(string); rg != "" {
-		g.Resources, err = g.createResourcesByResourceGroup(ctx, rg, vaultsClient)
+func (az *KeyVaultGenerator) InitResources() error {
+	vaults, err := az.listVaults()
+	if err != nil {
 		return err
 	}
-	g.Resources, err = g.createResources(ctx, vaultsClient)
-	return err
+	var unreachable []string
+	for _, vault := range vaults {
+		az.AppendSimpleResource(*vault.ID, *vault.Name, "azurerm_key_vault")
+		if vault.Properties == nil {
+			continue
+		}
+		az.appendAccessPolicies(vault)
+		if err := az.appendVaultContents(vault); err != nil {
+			if acls := vault.Properties.NetworkAcls; acls != nil && acls.DefaultAction == keyvault.Deny {
+				log.Printf("key vault %s denies access from networks not allowed by its network rules", *vault.Name)
+			}
+			log.Printf("can't list the contents of key vault %s: %v", *vault.Name, err)
+			unreachable = append(unreachable, *vault.Name)
+		}
+	}
+	if len(unreachable) > 0 {
+		log.Printf("keys, secrets and certificates of key vaults %s are not imported", strings.Join(unreachable, ", "))
+	}
+	// managed HSMs are skipped when the provider isn't registered or can't be accessed
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendManagedHSMs(rgName); err != nil {
+			log.Printf("can't list managed HSMs: %v", err)
+		}
+	}
+	return nil
+}
+
+// PostConvertHook leaves the access policies imported separately out of the vaults,
+// links the vault contents to their vault and replaces secret values with variables
+func (az *KeyVaultGenerator) PostConvertHook() error {
+	for i, r := range az.Resources {
+		switch r.InstanceInfo.Type {
+		case "azurerm_key_vault":
+			delete(az.Resources[i].Item, "access_policy")
+		case "azurerm_key_vault_secret":
+			// secret values are never written out, whatever --redact-secrets and --strip-secrets say
+			az.Resources[i].Item["value"] = secrets.AddVariable(&az.Resources[i], []string{"value"})
+			az.Resources[i].InstanceState.Attributes["value"] = ""
+		}
+	}
+	az.linkResourceIDs("key_vault_id")
+	return nil
 }
@@ -0,0 +1,92 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/kusto/mgmt/2022-02-01/kusto"
+)
+
+type KustoGenerator struct {
+	AzureService
+}
+
+// appendDatabases appends the read-write databases of the cluster, follower databases
+// are attached by the leader cluster
+func (az *KustoGenerator) appendDatabases(resourceGroup, clusterName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := kusto.NewDatabasesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	result, err := client.ListByCluster(context.Background(), resourceGroup, clusterName)
+	if err != nil {
+		return err
+	}
+	if result.Value == nil {
+		return nil
+	}
+	for _, item := range *result.Value {
+		database, ok := item.AsReadWriteDatabase()
+		if !ok {
+			continue
+		}
+		// the name is "<cluster>/<database>"
+		az.AppendSimpleResource(*database.ID, clusterName+"_"+lastIDSegment(*database.Name), "azurerm_kusto_database")
+	}
+	return nil
+}
+
+func (az *KustoGenerator) appendClusters(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := kusto.NewClustersClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		result kusto.ClusterListResult
+		err    error
+	)
+	if rgName == "" {
+		result, err = client.List(ctx)
+	} else {
+		result, err = client.ListByResourceGroup(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	if result.Value == nil {
+		return nil
+	}
+	for _, cluster := range *result.Value {
+		az.AppendSimpleResource(*cluster.ID, *cluster.Name, "azurerm_kusto_cluster")
+		id, err := ParseAzureResourceID(*cluster.ID)
+		if err != nil {
+			return err
+		}
+		if err := az.appendDatabases(id.ResourceGroup, *cluster.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *KustoGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendClusters(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
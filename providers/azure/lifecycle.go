@@ -0,0 +1,67 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+// Attributes changed by Azure itself (autoscaling) that would show up as drift. Tags set
+// by policies depend on the assignments of the tenant and come from --lifecycle-config
+var ignoreChanges = map[string][]string{
+	"azurerm_kubernetes_cluster":                     {"default_node_pool[0].node_count"},
+	"azurerm_kubernetes_cluster_node_pool":           {"node_count"},
+	"azurerm_virtual_machine_scale_set":              {"sku[0].capacity"},
+	"azurerm_linux_virtual_machine_scale_set":        {"instances"},
+	"azurerm_windows_virtual_machine_scale_set":      {"instances"},
+	"azurerm_orchestrated_virtual_machine_scale_set": {"instances"},
+}
+
+func (p *AzureProvider) GetIgnoreChanges() map[string][]string {
+	return ignoreChanges
+}
+
+// GetProtectedResources returns the resources in the scope of an imported azurerm_management_lock
+func (p *AzureProvider) GetProtectedResources(resources []*terraformutils.Resource) []*terraformutils.Resource {
+	var scopes []string
+	for _, r := range resources {
+		if r.InstanceInfo.Type != "azurerm_management_lock" {
+			continue
+		}
+		scope := r.InstanceState.Attributes["scope"]
+		if scope == "" {
+			scope = extensionResourceScope(r.InstanceState.ID, "Microsoft.Authorization/locks")
+		}
+		if scope != "" {
+			scopes = append(scopes, strings.ToLower(scope))
+		}
+	}
+	var protected []*terraformutils.Resource
+	for _, r := range resources {
+		if r.InstanceInfo.Type == "azurerm_management_lock" {
+			continue
+		}
+		id := strings.ToLower(r.InstanceState.ID)
+		for _, scope := range scopes {
+			if id == scope || strings.HasPrefix(id, scope+"/") {
+				protected = append(protected, r)
+				break
+			}
+		}
+	}
+	return protected
+}
@@ -19,7 +19,7 @@ import (
 	"log"
 	"regexp"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-03-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
 	"github.com/hashicorp/go-azure-helpers/authentication"
@@ -144,6 +144,7 @@ func (g *LoadBalancerGenerator) listLoadBalancerBackendAddressPools(resourceGrou
 			[]string{},
 			map[string]interface{}{},
 		))
+		resources = append(resources, g.listBackendAddresses(loadBalancerBackendAddressPool)...)
 		if err := loadBalancerBackendAddressPoolIterator.Next(); err != nil {
 			log.Println(err)
 			break
@@ -153,6 +154,134 @@ func (g *LoadBalancerGenerator) listLoadBalancerBackendAddressPools(resourceGrou
 	return resources, nil
 }
 
+// listBackendAddresses returns the IP addresses of the pool, the network interfaces in it
+// are imported by the network_interface service as associations
+func (g *LoadBalancerGenerator) listBackendAddresses(pool network.BackendAddressPool) []terraformutils.Resource {
+	var resources []terraformutils.Resource
+	if pool.BackendAddressPoolPropertiesFormat == nil || pool.LoadBalancerBackendAddresses == nil {
+		return resources
+	}
+	for _, address := range *pool.LoadBalancerBackendAddresses {
+		if address.Name == nil || address.LoadBalancerBackendAddressPropertiesFormat == nil || address.VirtualNetwork == nil {
+			continue
+		}
+		resources = append(resources, terraformutils.NewResource(
+			*pool.ID+"/addresses/"+*address.Name,
+			*pool.Name+"_"+*address.Name,
+			"azurerm_lb_backend_address_pool_address",
+			g.ProviderName,
+			map[string]string{
+				"backend_address_pool_id": *pool.ID,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return resources
+}
+
+func (g *LoadBalancerGenerator) listLoadBalancingRules(resourceGroupName string, loadBalancerName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+
+	LoadBalancingRulesClient := network.NewLoadBalancerLoadBalancingRulesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	LoadBalancingRulesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	loadBalancingRuleIterator, err := LoadBalancingRulesClient.ListComplete(ctx, resourceGroupName, loadBalancerName)
+
+	if err != nil {
+		return nil, err
+	}
+	for loadBalancingRuleIterator.NotDone() {
+		loadBalancingRule := loadBalancingRuleIterator.Value()
+		// NOTE:
+		// Similar to above explanation, work out loadbalancer_id for azurerm datasource impl
+		re := regexp.MustCompile(`/loadBalancingRules/.*$`)
+		loadBalancerID := re.ReplaceAllLiteralString(*loadBalancingRule.ID, "")
+		resources = append(resources, terraformutils.NewResource(
+			*loadBalancingRule.ID,
+			*loadBalancingRule.Name,
+			"azurerm_lb_rule",
+			g.ProviderName,
+			map[string]string{
+				"loadbalancer_id": loadBalancerID,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+
+		if err := loadBalancingRuleIterator.Next(); err != nil {
+			log.Println(err)
+			break
+		}
+	}
+
+	return resources, nil
+}
+
+func (g *LoadBalancerGenerator) listOutboundRules(resourceGroupName string, loadBalancerName string) ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	ctx := context.Background()
+	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
+	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
+
+	OutboundRulesClient := network.NewLoadBalancerOutboundRulesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	OutboundRulesClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+	outboundRuleIterator, err := OutboundRulesClient.ListComplete(ctx, resourceGroupName, loadBalancerName)
+
+	if err != nil {
+		return nil, err
+	}
+	for outboundRuleIterator.NotDone() {
+		outboundRule := outboundRuleIterator.Value()
+		// NOTE:
+		// Similar to above explanation, work out loadbalancer_id for azurerm datasource impl
+		re := regexp.MustCompile(`/outboundRules/.*$`)
+		loadBalancerID := re.ReplaceAllLiteralString(*outboundRule.ID, "")
+		resources = append(resources, terraformutils.NewResource(
+			*outboundRule.ID,
+			*outboundRule.Name,
+			"azurerm_lb_outbound_rule",
+			g.ProviderName,
+			map[string]string{
+				"loadbalancer_id": loadBalancerID,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+
+		if err := outboundRuleIterator.Next(); err != nil {
+			log.Println(err)
+			break
+		}
+	}
+
+	return resources, nil
+}
+
+// listInboundNatPools returns the NAT pools of the load balancer, which have no list API of their own
+func (g *LoadBalancerGenerator) listInboundNatPools(loadBalancer network.LoadBalancer) []terraformutils.Resource {
+	var resources []terraformutils.Resource
+	if loadBalancer.LoadBalancerPropertiesFormat == nil || loadBalancer.InboundNatPools == nil {
+		return resources
+	}
+	for _, natPool := range *loadBalancer.InboundNatPools {
+		resources = append(resources, terraformutils.NewResource(
+			*natPool.ID,
+			*natPool.Name,
+			"azurerm_lb_nat_pool",
+			g.ProviderName,
+			map[string]string{
+				"loadbalancer_id": *loadBalancer.ID,
+			},
+			[]string{},
+			map[string]interface{}{},
+		))
+	}
+	return resources
+}
+
 func (g *LoadBalancerGenerator) listAndAddForLoadBalancers() ([]terraformutils.Resource, error) {
 	var resources []terraformutils.Resource
 	ctx := context.Background()
@@ -208,6 +337,20 @@ func (g *LoadBalancerGenerator) listAndAddForLoadBalancers() ([]terraformutils.R
 		}
 		resources = append(resources, backendAddressPools...)
 
+		loadBalancingRules, err := g.listLoadBalancingRules(id.ResourceGroup, *loadBalancer.Name)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, loadBalancingRules...)
+
+		outboundRules, err := g.listOutboundRules(id.ResourceGroup, *loadBalancer.Name)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, outboundRules...)
+
+		resources = append(resources, g.listInboundNatPools(loadBalancer)...)
+
 		if err := loadBalancerIterator.Next(); err != nil {
 			log.Println(err)
 			return resources, err
@@ -232,3 +375,9 @@ func (g *LoadBalancerGenerator) InitResources() error {
 
 	return nil
 }
+
+// PostConvertHook links the rules, pools and probes to the imported load balancer and to each other
+func (g *LoadBalancerGenerator) PostConvertHook() error {
+	g.linkResourceIDs("loadbalancer_id", "backend_address_pool_id", "backend_address_pool_ids", "probe_id")
+	return nil
+}
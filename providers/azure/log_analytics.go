@@ -0,0 +1,105 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/operationalinsights/mgmt/2020-08-01/operationalinsights"
+	"github.com/Azure/azure-sdk-for-go/services/preview/operationsmanagement/mgmt/2015-11-01-preview/operationsmanagement"
+)
+
+type LogAnalyticsGenerator struct {
+	AzureService
+}
+
+func (az *LogAnalyticsGenerator) listWorkspaces() ([]operationalinsights.Workspace, error) {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := operationalinsights.NewWorkspacesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	if resourceGroup == "" {
+		result, err := client.List(ctx)
+		if err != nil || result.Value == nil {
+			return nil, err
+		}
+		return *result.Value, nil
+	}
+	var workspaces []operationalinsights.Workspace
+	for _, rgName := range strings.Split(resourceGroup, ",") {
+		result, err := client.ListByResourceGroup(ctx, strings.TrimSpace(rgName))
+		if err != nil {
+			return workspaces, err
+		}
+		if result.Value != nil {
+			workspaces = append(workspaces, *result.Value...)
+		}
+	}
+	return workspaces, nil
+}
+
+func (az *LogAnalyticsGenerator) listSolutions() ([]operationsmanagement.Solution, error) {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := operationsmanagement.NewSolutionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID, "Microsoft.OperationalInsights", "workspaces", "")
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	if resourceGroup == "" {
+		result, err := client.ListBySubscription(ctx)
+		if err != nil || result.Value == nil {
+			return nil, err
+		}
+		return *result.Value, nil
+	}
+	var solutions []operationsmanagement.Solution
+	for _, rgName := range strings.Split(resourceGroup, ",") {
+		result, err := client.ListByResourceGroup(ctx, strings.TrimSpace(rgName))
+		if err != nil {
+			return solutions, err
+		}
+		if result.Value != nil {
+			solutions = append(solutions, *result.Value...)
+		}
+	}
+	return solutions, nil
+}
+
+func (az *LogAnalyticsGenerator) InitResources() error {
+	workspaces, err := az.listWorkspaces()
+	if err != nil {
+		return err
+	}
+	for _, workspace := range workspaces {
+		az.AppendSimpleResource(*workspace.ID, *workspace.Name, "azurerm_log_analytics_workspace")
+	}
+	// solutions need the Microsoft.OperationsManagement resource provider
+	solutions, err := az.listSolutions()
+	if err != nil {
+		log.Println(err)
+	}
+	for _, solution := range solutions {
+		az.AppendSimpleResource(*solution.ID, *solution.Name, "azurerm_log_analytics_solution")
+	}
+	return nil
+}
+
+// PostConvertHook links solutions to their imported workspace
+func (az *LogAnalyticsGenerator) PostConvertHook() error {
+	az.linkResourceIDs("workspace_resource_id")
+	return nil
+}
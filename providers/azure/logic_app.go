@@ -0,0 +1,184 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2019-05-01/logic"
+)
+
+type LogicAppGenerator struct {
+	AzureService
+}
+
+// appendWorkflowDefinition appends the triggers and actions of the workflow definition as custom
+// triggers and actions, their JSON body is moved to data files by PostConvertHook
+func (az *LogicAppGenerator) appendWorkflowDefinition(workflow logic.Workflow) {
+	if workflow.WorkflowProperties == nil {
+		return
+	}
+	definition, ok := workflow.Definition.(map[string]interface{})
+	if !ok {
+		return
+	}
+	parts := []struct {
+		key          string
+		resourceType string
+	}{
+		{"triggers", "azurerm_logic_app_trigger_custom"},
+		{"actions", "azurerm_logic_app_action_custom"},
+	}
+	for _, part := range parts {
+		items, ok := definition[part.key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name := range items {
+			az.AppendSimpleResource(*workflow.ID+"/"+part.key+"/"+name, *workflow.Name+"_"+name, part.resourceType)
+		}
+	}
+}
+
+func (az *LogicAppGenerator) appendWorkflows(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := logic.NewWorkflowsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator logic.WorkflowListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionComplete(ctx, nil, "")
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName, nil, "")
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		workflow := iterator.Value()
+		az.AppendSimpleResource(*workflow.ID, *workflow.Name, "azurerm_logic_app_workflow")
+		az.appendWorkflowDefinition(workflow)
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *LogicAppGenerator) appendIntegrationServiceEnvironments(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := logic.NewIntegrationServiceEnvironmentsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator logic.IntegrationServiceEnvironmentListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionComplete(ctx, nil)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName, nil)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		az.AppendSimpleResource(*item.ID, *item.Name, "azurerm_integration_service_environment")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// appendAPIConnections appends the managed API connections used by workflows, the vendored
+// SDK has no client for them
+func (az *LogicAppGenerator) appendAPIConnections(rgName string) error {
+	path := "/subscriptions/{subscriptionId}/providers/Microsoft.Web/connections"
+	if rgName != "" {
+		path = "/subscriptions/{subscriptionId}/resourceGroups/" + rgName + "/providers/Microsoft.Web/connections"
+	}
+	connections, err := az.listARMResources(path, "2016-06-01")
+	if err != nil {
+		return err
+	}
+	for _, connection := range connections {
+		az.AppendSimpleResource(connection.ID, connection.Name, "azurerm_api_connection")
+	}
+	return nil
+}
+
+func (az *LogicAppGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendWorkflows(rgName); err != nil {
+			return err
+		}
+		if err := az.appendIntegrationServiceEnvironments(rgName); err != nil {
+			return err
+		}
+		if err := az.appendAPIConnections(rgName); err != nil {
+			return err
+		}
+	}
+	sites, err := az.listApps()
+	if err != nil {
+		return err
+	}
+	for _, site := range sites {
+		if isWorkflowApp(site) {
+			az.AppendSimpleResource(*site.ID, *site.Name, "azurerm_logic_app_standard")
+		}
+	}
+	return nil
+}
+
+// PostConvertHook writes the bodies of triggers and actions to indented JSON files and links
+// them to their workflow
+func (az *LogicAppGenerator) PostConvertHook() error {
+	// a trigger and an action of a workflow can have the same name
+	fileSuffixes := map[string]string{
+		"azurerm_logic_app_trigger_custom": ".trigger.json",
+		"azurerm_logic_app_action_custom":  ".action.json",
+	}
+	for i, r := range az.Resources {
+		fileSuffix, ok := fileSuffixes[r.InstanceInfo.Type]
+		if !ok {
+			continue
+		}
+		body := r.InstanceState.Attributes["body"]
+		if body == "" {
+			continue
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, []byte(body), "", "  "); err != nil {
+			log.Println(err)
+			continue
+		}
+		az.Resources[i].Item["body"] = writeDataFile(&az.Resources[i], r.ResourceName+fileSuffix, indented.Bytes())
+	}
+	az.linkResourceIDs("logic_app_id", "integration_service_environment_id")
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/machinelearningservices/mgmt/2021-07-01/machinelearningservices"
+)
+
+type MachineLearningGenerator struct {
+	AzureService
+}
+
+// appendComputes appends the compute instances and clusters of the workspace, attached
+// computes like Kubernetes clusters or Databricks workspaces are skipped
+func (az *MachineLearningGenerator) appendComputes(resourceGroup, workspaceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := machinelearningservices.NewComputeClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, resourceGroup, workspaceName, "")
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		resourceName := workspaceName + "_" + *item.Name
+		if item.Properties != nil {
+			if _, ok := item.Properties.AsComputeInstance(); ok {
+				az.AppendSimpleResource(*item.ID, resourceName, "azurerm_machine_learning_compute_instance")
+			} else if _, ok := item.Properties.AsAmlCompute(); ok {
+				az.AppendSimpleResource(*item.ID, resourceName, "azurerm_machine_learning_compute_cluster")
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *MachineLearningGenerator) appendWorkspaces(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := machinelearningservices.NewWorkspacesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator machinelearningservices.WorkspaceListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionComplete(ctx, "")
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName, "")
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		workspace := iterator.Value()
+		az.AppendSimpleResource(*workspace.ID, *workspace.Name, "azurerm_machine_learning_workspace")
+		id, err := ParseAzureResourceID(*workspace.ID)
+		if err != nil {
+			return err
+		}
+		if err := az.appendComputes(id.ResourceGroup, *workspace.Name); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *MachineLearningGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendWorkspaces(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links the computes to the imported workspaces
+func (az *MachineLearningGenerator) PostConvertHook() error {
+	az.linkResourceIDs("machine_learning_workspace_id")
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-05-01/managementgroups"
+)
+
+const managementGroupIDPrefix = "/providers/Microsoft.Management/managementGroups/"
+
+type ManagementGroupGenerator struct {
+	AzureService
+}
+
+type managementGroupInfo struct {
+	Name        string
+	DisplayName string
+	// the tenant root group holds policies but can't be managed itself
+	Root bool
+}
+
+func newManagementGroupInfo(name string, displayName *string, tenantID *string) managementGroupInfo {
+	group := managementGroupInfo{Name: name, Root: tenantID != nil && strings.EqualFold(*tenantID, name)}
+	if displayName != nil {
+		group.DisplayName = *displayName
+	}
+	return group
+}
+
+// listManagementGroups returns managementGroup and all management groups below it,
+// or every management group visible to the caller when managementGroup is empty
+func (az *AzureService) listManagementGroups(managementGroup string) ([]managementGroupInfo, error) {
+	_, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := managementgroups.NewClientWithBaseURI(resourceManagerEndpoint)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var groups []managementGroupInfo
+	if managementGroup == "" {
+		iterator, err := client.ListComplete(ctx, "", "")
+		if err != nil {
+			return nil, err
+		}
+		for iterator.NotDone() {
+			item := iterator.Value()
+			if item.InfoProperties != nil {
+				groups = append(groups, newManagementGroupInfo(*item.Name, item.DisplayName, item.TenantID))
+			} else {
+				groups = append(groups, managementGroupInfo{Name: *item.Name})
+			}
+			if err := iterator.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return groups, err
+			}
+		}
+		return groups, nil
+	}
+
+	root, err := client.Get(ctx, managementGroup, "", nil, "", "")
+	if err != nil {
+		return nil, err
+	}
+	if root.Properties != nil {
+		groups = append(groups, newManagementGroupInfo(*root.Name, root.DisplayName, root.TenantID))
+	} else {
+		groups = append(groups, managementGroupInfo{Name: *root.Name})
+	}
+	iterator, err := client.GetDescendantsComplete(ctx, managementGroup, "", nil)
+	if err != nil {
+		return groups, err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		// descendants also include subscriptions
+		if item.Type != nil && strings.EqualFold(*item.Type, "Microsoft.Management/managementGroups") {
+			if item.DescendantInfoProperties != nil {
+				groups = append(groups, newManagementGroupInfo(*item.Name, item.DisplayName, nil))
+			} else {
+				groups = append(groups, managementGroupInfo{Name: *item.Name})
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return groups, err
+		}
+	}
+	return groups, nil
+}
+
+func (az *ManagementGroupGenerator) InitResources() error {
+	groups, err := az.listManagementGroups(az.getManagementGroup())
+	if err != nil {
+		return err
+	}
+	for _, group := range groups {
+		if group.Root {
+			continue
+		}
+		name := group.Name
+		if group.DisplayName != "" {
+			name = group.DisplayName
+		}
+		az.AppendSimpleResource(managementGroupIDPrefix+group.Name, name, "azurerm_management_group")
+	}
+	return nil
+}
+
+// PostConvertHook links child management groups to their imported parent
+func (az *ManagementGroupGenerator) PostConvertHook() error {
+	az.linkResourceIDs("parent_management_group_id")
+	return nil
+}
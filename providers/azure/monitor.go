@@ -0,0 +1,190 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/monitor/mgmt/2021-08-01/scheduledqueryrules"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+)
+
+type MonitorGenerator struct {
+	AzureService
+}
+
+func (az *MonitorGenerator) appendActionGroups(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := insights.NewActionGroupsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		result insights.ActionGroupList
+		err    error
+	)
+	if rgName == "" {
+		result, err = client.ListBySubscriptionID(ctx)
+	} else {
+		result, err = client.ListByResourceGroup(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	if result.Value != nil {
+		for _, actionGroup := range *result.Value {
+			az.AppendSimpleResource(*actionGroup.ID, *actionGroup.Name, "azurerm_monitor_action_group")
+		}
+	}
+	return nil
+}
+
+func (az *MonitorGenerator) appendMetricAlerts(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := insights.NewMetricAlertsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		result insights.MetricAlertResourceCollection
+		err    error
+	)
+	if rgName == "" {
+		result, err = client.ListBySubscription(ctx)
+	} else {
+		result, err = client.ListByResourceGroup(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	if result.Value != nil {
+		for _, alert := range *result.Value {
+			az.AppendSimpleResource(*alert.ID, *alert.Name, "azurerm_monitor_metric_alert")
+		}
+	}
+	return nil
+}
+
+func (az *MonitorGenerator) appendActivityLogAlerts(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := insights.NewActivityLogAlertsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator insights.AlertRuleListIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionIDComplete(ctx)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		alert := iterator.Value()
+		az.AppendSimpleResource(*alert.ID, *alert.Name, "azurerm_monitor_activity_log_alert")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *MonitorGenerator) appendScheduledQueryRules(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := scheduledqueryrules.NewClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator scheduledqueryrules.ResourceCollectionIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionComplete(ctx)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		rule := iterator.Value()
+		// log to metric rules have no v2 resource
+		if rule.Kind != scheduledqueryrules.KindLogToMetric {
+			az.AppendSimpleResource(*rule.ID, *rule.Name, "azurerm_monitor_scheduled_query_rules_alert_v2")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *MonitorGenerator) appendDataCollectionRules(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := insights.NewDataCollectionRulesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator insights.DataCollectionRuleResourceListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListBySubscriptionComplete(ctx)
+	} else {
+		iterator, err = client.ListByResourceGroupComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		rule := iterator.Value()
+		az.AppendSimpleResource(*rule.ID, *rule.Name, "azurerm_monitor_data_collection_rule")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *MonitorGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendActionGroups(rgName); err != nil {
+			return err
+		}
+		if err := az.appendMetricAlerts(rgName); err != nil {
+			return err
+		}
+		if err := az.appendActivityLogAlerts(rgName); err != nil {
+			return err
+		}
+		if err := az.appendScheduledQueryRules(rgName); err != nil {
+			return err
+		}
+		if err := az.appendDataCollectionRules(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-08-01/network"
+)
+
+type NatGatewayGenerator struct {
+	AzureService
+}
+
+// appendPublicIPAssociations appends the associations of the gateway with public IP
+// addresses and prefixes, imported by "<gateway ID>|<public IP or prefix ID>"
+func (az *NatGatewayGenerator) appendPublicIPAssociations(gateway network.NatGateway) {
+	props := gateway.NatGatewayPropertiesFormat
+	if props == nil {
+		return
+	}
+	if props.PublicIPAddresses != nil {
+		for _, address := range *props.PublicIPAddresses {
+			resourceName := *gateway.Name + "_" + lastIDSegment(*address.ID)
+			az.appendSimpleAssociation(*gateway.ID+"|"+*address.ID, *gateway.Name, &resourceName,
+				"azurerm_nat_gateway_public_ip_association",
+				map[string]string{
+					"nat_gateway_id":       *gateway.ID,
+					"public_ip_address_id": *address.ID,
+				})
+		}
+	}
+	if props.PublicIPPrefixes != nil {
+		for _, prefix := range *props.PublicIPPrefixes {
+			resourceName := *gateway.Name + "_" + lastIDSegment(*prefix.ID)
+			az.appendSimpleAssociation(*gateway.ID+"|"+*prefix.ID, *gateway.Name, &resourceName,
+				"azurerm_nat_gateway_public_ip_prefix_association",
+				map[string]string{
+					"nat_gateway_id":      *gateway.ID,
+					"public_ip_prefix_id": *prefix.ID,
+				})
+		}
+	}
+}
+
+func (az *NatGatewayGenerator) appendNatGateways(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := network.NewNatGatewaysClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		iterator network.NatGatewayListResultIterator
+		err      error
+	)
+	if rgName == "" {
+		iterator, err = client.ListAllComplete(ctx)
+	} else {
+		iterator, err = client.ListComplete(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		gateway := iterator.Value()
+		az.AppendSimpleResource(*gateway.ID, *gateway.Name, "azurerm_nat_gateway")
+		az.appendPublicIPAssociations(gateway)
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *NatGatewayGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendNatGateways(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links the public IP associations to the imported gateways
+func (az *NatGatewayGenerator) PostConvertHook() error {
+	az.linkResourceIDs("nat_gateway_id")
+	return nil
+}
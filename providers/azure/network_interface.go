@@ -38,6 +38,7 @@ func (g NetworkInterfaceGenerator) createResources(interfaceListResult network.I
 			"azurerm_network_interface",
 			"azurerm",
 			[]string{}))
+		resources = append(resources, g.createBackendAddressPoolAssociations(networkInterface)...)
 		if err := interfaceListResult.Next(); err != nil {
 			log.Println(err)
 			return resources, err
@@ -46,6 +47,47 @@ func (g NetworkInterfaceGenerator) createResources(interfaceListResult network.I
 	return resources, nil
 }
 
+// createBackendAddressPoolAssociations returns the associations of the IP configurations
+// of the interface with load balancer and application gateway backend pools, imported by
+// "<IP configuration ID>|<backend pool ID>"
+func (g NetworkInterfaceGenerator) createBackendAddressPoolAssociations(networkInterface network.Interface) []terraformutils.Resource {
+	var resources []terraformutils.Resource
+	if networkInterface.InterfacePropertiesFormat == nil || networkInterface.IPConfigurations == nil {
+		return resources
+	}
+	for _, ipConfiguration := range *networkInterface.IPConfigurations {
+		props := ipConfiguration.InterfaceIPConfigurationPropertiesFormat
+		if props == nil {
+			continue
+		}
+		appendAssociation := func(poolID, resourceType string) {
+			resources = append(resources, terraformutils.NewResource(
+				*ipConfiguration.ID+"|"+poolID,
+				*networkInterface.Name+"_"+*ipConfiguration.Name+"_"+lastIDSegment(poolID),
+				resourceType,
+				"azurerm",
+				map[string]string{
+					"network_interface_id":    *networkInterface.ID,
+					"ip_configuration_name":   *ipConfiguration.Name,
+					"backend_address_pool_id": poolID,
+				},
+				[]string{},
+				map[string]interface{}{}))
+		}
+		if props.LoadBalancerBackendAddressPools != nil {
+			for _, pool := range *props.LoadBalancerBackendAddressPools {
+				appendAssociation(*pool.ID, "azurerm_network_interface_backend_address_pool_association")
+			}
+		}
+		if props.ApplicationGatewayBackendAddressPools != nil {
+			for _, pool := range *props.ApplicationGatewayBackendAddressPools {
+				appendAssociation(*pool.ID, "azurerm_network_interface_application_gateway_backend_address_pool_association")
+			}
+		}
+	}
+	return resources
+}
+
 func (g *NetworkInterfaceGenerator) InitResources() error {
 	ctx := context.Background()
 	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
@@ -68,3 +110,9 @@ func (g *NetworkInterfaceGenerator) InitResources() error {
 	g.Resources, err = g.createResources(output)
 	return err
 }
+
+// PostConvertHook links the backend pool associations to the imported interfaces
+func (g *NetworkInterfaceGenerator) PostConvertHook() error {
+	g.linkResourceIDs("network_interface_id")
+	return nil
+}
@@ -0,0 +1,342 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/policyinsights/mgmt/2019-10-01-preview/policyinsights"
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy"
+)
+
+// built-in definitions can't be managed
+const customPolicyFilter = "policyType eq 'Custom'"
+
+type PolicyGenerator struct {
+	AzureService
+}
+
+type policyClients struct {
+	definitions    policy.DefinitionsClient
+	setDefinitions policy.SetDefinitionsClient
+	assignments    policy.AssignmentsClient
+	exemptions     policy.ExemptionsClient
+	remediations   policyinsights.RemediationsClient
+}
+
+// policyScopeType returns the azurerm resource type prefix of assignments,
+// exemptions and remediations at scope
+func policyScopeType(scope string) string {
+	segments := strings.Split(strings.Trim(strings.ToLower(scope), "/"), "/")
+	switch {
+	case len(segments) == 4 && segments[0] == "providers" && segments[1] == "microsoft.management" && segments[2] == "managementgroups":
+		return "management_group"
+	case len(segments) == 2 && segments[0] == "subscriptions":
+		return "subscription"
+	case len(segments) == 4 && segments[0] == "subscriptions" && segments[2] == "resourcegroups":
+		return "resource_group"
+	}
+	return "resource"
+}
+
+// appendScopedResource appends a policy assignment, exemption or remediation,
+// names are prefixed with the scope name as they're only unique per scope
+func (az *PolicyGenerator) appendScopedResource(id, name, scope, kind string) {
+	segments := strings.Split(strings.TrimSuffix(scope, "/"), "/")
+	resourceName := segments[len(segments)-1] + "_" + name
+	az.AppendSimpleResource(id, resourceName, fmt.Sprintf("azurerm_%s_policy_%s", policyScopeType(scope), kind))
+}
+
+// appendDefinitions appends the custom definitions defined at or below scope,
+// only the referenced ones when referenced isn't nil
+func (az *PolicyGenerator) appendDefinitions(iterator policy.DefinitionListResultIterator, scope string, referenced map[string]bool) error {
+	ctx := context.Background()
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if isScopeOf(scope, *item.ID) && (referenced == nil || referenced[strings.ToLower(*item.ID)]) {
+			name := *item.Name
+			if item.DefinitionProperties != nil && item.DisplayName != nil {
+				name = *item.DisplayName
+			}
+			az.AppendSimpleResource(*item.ID, name, "azurerm_policy_definition")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// appendSetDefinitions appends the custom set definitions defined at or below scope,
+// when referenced isn't nil only the referenced ones, adding their definitions to referenced
+func (az *PolicyGenerator) appendSetDefinitions(iterator policy.SetDefinitionListResultIterator, scope string, referenced map[string]bool) error {
+	ctx := context.Background()
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if isScopeOf(scope, *item.ID) && (referenced == nil || referenced[strings.ToLower(*item.ID)]) {
+			name := *item.Name
+			if item.SetDefinitionProperties != nil && item.DisplayName != nil {
+				name = *item.DisplayName
+			}
+			az.AppendSimpleResource(*item.ID, name, "azurerm_policy_set_definition")
+			if referenced != nil && item.SetDefinitionProperties != nil && item.PolicyDefinitions != nil {
+				for _, reference := range *item.PolicyDefinitions {
+					if reference.PolicyDefinitionID != nil {
+						referenced[strings.ToLower(*reference.PolicyDefinitionID)] = true
+					}
+				}
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// appendAssignments appends the assignments at or below scope, assignments inherited
+// from a parent scope are left out. Assigned definitions are added to referenced.
+func (az *PolicyGenerator) appendAssignments(iterator policy.AssignmentListResultIterator, scope string, referenced map[string]bool) error {
+	ctx := context.Background()
+	for iterator.NotDone() {
+		item := iterator.Value()
+		assignmentScope := extensionResourceScope(*item.ID, "Microsoft.Authorization/policyAssignments")
+		if item.AssignmentProperties != nil && item.Scope != nil {
+			assignmentScope = *item.Scope
+		}
+		if assignmentScope != "" && isScopeOf(scope, assignmentScope) {
+			az.appendScopedResource(*item.ID, *item.Name, assignmentScope, "assignment")
+			if referenced != nil && item.AssignmentProperties != nil && item.PolicyDefinitionID != nil {
+				referenced[strings.ToLower(*item.PolicyDefinitionID)] = true
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *PolicyGenerator) appendExemptions(iterator policy.ExemptionListResultIterator, scope string) error {
+	ctx := context.Background()
+	for iterator.NotDone() {
+		item := iterator.Value()
+		exemptionScope := extensionResourceScope(*item.ID, "Microsoft.Authorization/policyExemptions")
+		if exemptionScope != "" && isScopeOf(scope, exemptionScope) {
+			az.appendScopedResource(*item.ID, *item.Name, exemptionScope, "exemption")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *PolicyGenerator) appendRemediations(iterator policyinsights.RemediationListResultIterator, scope string) error {
+	ctx := context.Background()
+	for iterator.NotDone() {
+		item := iterator.Value()
+		remediationScope := extensionResourceScope(*item.ID, "Microsoft.PolicyInsights/remediations")
+		if remediationScope != "" && isScopeOf(scope, remediationScope) {
+			az.appendScopedResource(*item.ID, *item.Name, remediationScope, "remediation")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// initManagementGroup imports the policies of a management group, without the ones
+// of its subscriptions
+func (az *PolicyGenerator) initManagementGroup(clients policyClients, managementGroup string) {
+	ctx := context.Background()
+	scope := managementGroupIDPrefix + managementGroup
+
+	definitions, err := clients.definitions.ListByManagementGroupComplete(ctx, managementGroup, customPolicyFilter, nil)
+	if err == nil {
+		err = az.appendDefinitions(definitions, scope, nil)
+	}
+	if err != nil {
+		log.Println(err)
+	}
+	setDefinitions, err := clients.setDefinitions.ListByManagementGroupComplete(ctx, managementGroup, customPolicyFilter, nil)
+	if err == nil {
+		err = az.appendSetDefinitions(setDefinitions, scope, nil)
+	}
+	if err != nil {
+		log.Println(err)
+	}
+	assignments, err := clients.assignments.ListForManagementGroupComplete(ctx, managementGroup, "atScope()", nil)
+	if err == nil {
+		err = az.appendAssignments(assignments, scope, nil)
+	}
+	if err != nil {
+		log.Println(err)
+	}
+	exemptions, err := clients.exemptions.ListForManagementGroupComplete(ctx, managementGroup, "atScope()")
+	if err == nil {
+		err = az.appendExemptions(exemptions, scope)
+	}
+	if err != nil {
+		log.Println(err)
+	}
+	remediations, err := clients.remediations.ListForManagementGroupComplete(ctx, managementGroup, nil, "")
+	if err == nil {
+		err = az.appendRemediations(remediations, scope)
+	}
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// initResourceGroups imports the policies of the resource groups and the custom
+// definitions they assign
+func (az *PolicyGenerator) initResourceGroups(clients policyClients, subscriptionID string, resourceGroups []string) {
+	ctx := context.Background()
+	referenced := map[string]bool{}
+	for _, rgName := range resourceGroups {
+		rgName = strings.TrimSpace(rgName)
+		scope := "/subscriptions/" + subscriptionID + "/resourceGroups/" + rgName
+
+		assignments, err := clients.assignments.ListForResourceGroupComplete(ctx, rgName, "", nil)
+		if err == nil {
+			err = az.appendAssignments(assignments, scope, referenced)
+		}
+		if err != nil {
+			log.Println(err)
+		}
+		exemptions, err := clients.exemptions.ListForResourceGroupComplete(ctx, rgName, "")
+		if err == nil {
+			err = az.appendExemptions(exemptions, scope)
+		}
+		if err != nil {
+			log.Println(err)
+		}
+		remediations, err := clients.remediations.ListForResourceGroupComplete(ctx, subscriptionID, rgName, nil, "")
+		if err == nil {
+			err = az.appendRemediations(remediations, scope)
+		}
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	scope := "/subscriptions/" + subscriptionID
+	setDefinitions, err := clients.setDefinitions.ListComplete(ctx, customPolicyFilter, nil)
+	if err == nil {
+		err = az.appendSetDefinitions(setDefinitions, scope, referenced)
+	}
+	if err != nil {
+		log.Println(err)
+	}
+	definitions, err := clients.definitions.ListComplete(ctx, customPolicyFilter, nil)
+	if err == nil {
+		err = az.appendDefinitions(definitions, scope, referenced)
+	}
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+func (az *PolicyGenerator) initSubscription(clients policyClients, subscriptionID string) error {
+	ctx := context.Background()
+	scope := "/subscriptions/" + subscriptionID
+
+	definitions, err := clients.definitions.ListComplete(ctx, customPolicyFilter, nil)
+	if err != nil {
+		return err
+	}
+	if err := az.appendDefinitions(definitions, scope, nil); err != nil {
+		return err
+	}
+	setDefinitions, err := clients.setDefinitions.ListComplete(ctx, customPolicyFilter, nil)
+	if err != nil {
+		return err
+	}
+	if err := az.appendSetDefinitions(setDefinitions, scope, nil); err != nil {
+		return err
+	}
+	assignments, err := clients.assignments.ListComplete(ctx, "", nil)
+	if err != nil {
+		return err
+	}
+	if err := az.appendAssignments(assignments, scope, nil); err != nil {
+		return err
+	}
+	exemptions, err := clients.exemptions.ListComplete(ctx, "")
+	if err == nil {
+		err = az.appendExemptions(exemptions, scope)
+	}
+	if err != nil {
+		log.Println(err)
+	}
+	// remediations need the Microsoft.PolicyInsights resource provider
+	remediations, err := clients.remediations.ListForSubscriptionComplete(ctx, subscriptionID, nil, "")
+	if err == nil {
+		err = az.appendRemediations(remediations, scope)
+	}
+	if err != nil {
+		log.Println(err)
+	}
+	return nil
+}
+
+func (az *PolicyGenerator) InitResources() error {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	clients := policyClients{
+		definitions:    policy.NewDefinitionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID),
+		setDefinitions: policy.NewSetDefinitionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID),
+		assignments:    policy.NewAssignmentsClientWithBaseURI(resourceManagerEndpoint, subscriptionID),
+		exemptions:     policy.NewExemptionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID),
+		remediations:   policyinsights.NewRemediationsClientWithBaseURI(resourceManagerEndpoint),
+	}
+	clients.definitions.Authorizer = authorizer
+	clients.setDefinitions.Authorizer = authorizer
+	clients.assignments.Authorizer = authorizer
+	clients.exemptions.Authorizer = authorizer
+	clients.remediations.Authorizer = authorizer
+
+	if managementGroup := az.getManagementGroup(); managementGroup != "" {
+		groups, err := az.listManagementGroups(managementGroup)
+		if err != nil {
+			return err
+		}
+		for _, group := range groups {
+			az.initManagementGroup(clients, group.Name)
+		}
+		return nil
+	}
+	if resourceGroup != "" {
+		az.initResourceGroups(clients, subscriptionID, strings.Split(resourceGroup, ","))
+		return nil
+	}
+	return az.initSubscription(clients, subscriptionID)
+}
+
+// PostConvertHook links assignments, exemptions and remediations to the imported
+// definitions and assignments they refer to
+func (az *PolicyGenerator) PostConvertHook() error {
+	az.linkResourceIDs("policy_definition_id", "policy_assignment_id")
+	return nil
+}
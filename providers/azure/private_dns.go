@@ -221,9 +221,105 @@ func (g *PrivateDNSGenerator) listAndAddForPrivateDNSZone() ([]terraformutils.Re
 	return resources, nil
 }
 
+// dnsResolverAPIVersion is the API version of DNS Private Resolver, the vendored SDK has no client for it
+const dnsResolverAPIVersion = "2022-07-01"
+
+// dnsResolverPath returns the path listing the resources of the type in the resource group,
+// or in the subscription when rgName is empty
+func dnsResolverPath(rgName, resourceType string) string {
+	if rgName == "" {
+		return "/subscriptions/{subscriptionId}/providers/Microsoft.Network/" + resourceType
+	}
+	return "/subscriptions/{subscriptionId}/resourceGroups/" + rgName + "/providers/Microsoft.Network/" + resourceType
+}
+
+// listDNSResolvers lists the DNS private resolvers with their inbound and outbound endpoints
+func (g *PrivateDNSGenerator) listDNSResolvers() ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	endpointTypes := []struct {
+		path         string
+		resourceType string
+	}{
+		{"/inboundEndpoints", "azurerm_private_dns_resolver_inbound_endpoint"},
+		{"/outboundEndpoints", "azurerm_private_dns_resolver_outbound_endpoint"},
+	}
+	for _, rgName := range g.resourceGroups() {
+		resolvers, err := g.listARMResources(dnsResolverPath(rgName, "dnsResolvers"), dnsResolverAPIVersion)
+		if err != nil {
+			return resources, err
+		}
+		for _, resolver := range resolvers {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				resolver.ID,
+				resolver.Name,
+				"azurerm_private_dns_resolver",
+				g.ProviderName,
+				[]string{}))
+			for _, endpointType := range endpointTypes {
+				endpoints, err := g.listARMResources(resolver.ID+endpointType.path, dnsResolverAPIVersion)
+				if err != nil {
+					return resources, err
+				}
+				for _, endpoint := range endpoints {
+					resources = append(resources, terraformutils.NewSimpleResource(
+						endpoint.ID,
+						resolver.Name+"_"+endpoint.Name,
+						endpointType.resourceType,
+						g.ProviderName,
+						[]string{}))
+				}
+			}
+		}
+	}
+	return resources, nil
+}
+
+// listDNSForwardingRulesets lists the DNS forwarding rulesets with their rules and virtual network links
+func (g *PrivateDNSGenerator) listDNSForwardingRulesets() ([]terraformutils.Resource, error) {
+	var resources []terraformutils.Resource
+	childTypes := []struct {
+		path         string
+		resourceType string
+	}{
+		{"/forwardingRules", "azurerm_private_dns_resolver_forwarding_rule"},
+		{"/virtualNetworkLinks", "azurerm_private_dns_resolver_virtual_network_link"},
+	}
+	for _, rgName := range g.resourceGroups() {
+		rulesets, err := g.listARMResources(dnsResolverPath(rgName, "dnsForwardingRulesets"), dnsResolverAPIVersion)
+		if err != nil {
+			return resources, err
+		}
+		for _, ruleset := range rulesets {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				ruleset.ID,
+				ruleset.Name,
+				"azurerm_private_dns_resolver_dns_forwarding_ruleset",
+				g.ProviderName,
+				[]string{}))
+			for _, childType := range childTypes {
+				children, err := g.listARMResources(ruleset.ID+childType.path, dnsResolverAPIVersion)
+				if err != nil {
+					return resources, err
+				}
+				for _, child := range children {
+					resources = append(resources, terraformutils.NewSimpleResource(
+						child.ID,
+						ruleset.Name+"_"+child.Name,
+						childType.resourceType,
+						g.ProviderName,
+						[]string{}))
+				}
+			}
+		}
+	}
+	return resources, nil
+}
+
 func (g *PrivateDNSGenerator) InitResources() error {
 	functions := []func() ([]terraformutils.Resource, error){
 		g.listAndAddForPrivateDNSZone,
+		g.listDNSResolvers,
+		g.listDNSForwardingRulesets,
 	}
 
 	for _, f := range functions {
@@ -236,3 +332,9 @@ func (g *PrivateDNSGenerator) InitResources() error {
 
 	return nil
 }
+
+// PostConvertHook links the resolver endpoints, rules and links to the imported resolvers and rulesets
+func (g *PrivateDNSGenerator) PostConvertHook() error {
+	g.linkResourceIDs("private_dns_resolver_id", "dns_forwarding_ruleset_id", "private_dns_resolver_outbound_endpoint_ids")
+	return nil
+}
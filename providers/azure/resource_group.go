@@ -81,3 +81,28 @@ func (g *ResourceGroupGenerator) InitResources() error {
 		return nil
 	}
 }
+
+// listResourceGroupNames returns the resource groups given with -R, or all the
+// resource groups of the subscription, for APIs that can only list by resource group
+func (az *AzureService) listResourceGroupNames() ([]string, error) {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	if resourceGroup != "" {
+		return az.resourceGroups(), nil
+	}
+	client := resources.NewGroupsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for iterator.NotDone() {
+		names = append(names, *iterator.Value().Name)
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return names, err
+		}
+	}
+	return names, nil
+}
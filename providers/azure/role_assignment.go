@@ -0,0 +1,88 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2020-10-01/authorization"
+)
+
+type RoleAssignmentGenerator struct {
+	AzureService
+}
+
+// listResources returns the assignments at or below scope, assignments inherited
+// from a parent scope (e.g. a management group) are left out
+func (az *RoleAssignmentGenerator) listResources(iterator authorization.RoleAssignmentListResultIterator, scope string) ([]authorization.RoleAssignment, error) {
+	ctx := context.Background()
+	var resources []authorization.RoleAssignment
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if item.Properties != nil && item.Properties.Scope != nil && isScopeOf(scope, *item.Properties.Scope) {
+			resources = append(resources, item)
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (az *RoleAssignmentGenerator) InitResources() error {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := authorization.NewRoleAssignmentsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var resources []authorization.RoleAssignment
+	if resourceGroup != "" {
+		for _, rgName := range strings.Split(resourceGroup, ",") {
+			rgName = strings.TrimSpace(rgName)
+			iterator, err := client.ListForResourceGroupComplete(ctx, rgName, "")
+			if err != nil {
+				return err
+			}
+			rgResources, err := az.listResources(iterator, "/subscriptions/"+subscriptionID+"/resourceGroups/"+rgName)
+			if err != nil {
+				return err
+			}
+			resources = append(resources, rgResources...)
+		}
+	} else {
+		iterator, err := client.ListComplete(ctx, "")
+		if err != nil {
+			return err
+		}
+		resources, err = az.listResources(iterator, "/subscriptions/"+subscriptionID)
+		if err != nil {
+			return err
+		}
+	}
+	for _, resource := range resources {
+		az.AppendSimpleResource(*resource.ID, *resource.Name, "azurerm_role_assignment")
+	}
+	return nil
+}
+
+// isScopeOf reports whether id is scope itself or a resource below it
+func isScopeOf(scope, id string) bool {
+	scope = strings.ToLower(strings.TrimSuffix(scope, "/"))
+	id = strings.ToLower(id)
+	return id == scope || strings.HasPrefix(id, scope+"/")
+}
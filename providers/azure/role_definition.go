@@ -0,0 +1,85 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/authorization/mgmt/2020-10-01/authorization"
+)
+
+type RoleDefinitionGenerator struct {
+	AzureService
+}
+
+func (az *RoleDefinitionGenerator) listResources(scope string) ([]authorization.RoleDefinition, error) {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := authorization.NewRoleDefinitionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	// built-in roles can't be managed
+	iterator, err := client.ListComplete(ctx, scope, "type eq 'CustomRole'")
+	if err != nil {
+		return nil, err
+	}
+	var resources []authorization.RoleDefinition
+	for iterator.NotDone() {
+		resources = append(resources, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+// assignableInResourceGroups reports whether a custom role can be assigned in one of the resource groups
+func assignableInResourceGroups(definition authorization.RoleDefinition, subscriptionID string, resourceGroups []string) bool {
+	if definition.RoleDefinitionProperties == nil || definition.AssignableScopes == nil {
+		return false
+	}
+	for _, assignableScope := range *definition.AssignableScopes {
+		for _, rgName := range resourceGroups {
+			rgScope := "/subscriptions/" + subscriptionID + "/resourceGroups/" + strings.TrimSpace(rgName)
+			if isScopeOf(assignableScope, rgScope) || isScopeOf(rgScope, assignableScope) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (az *RoleDefinitionGenerator) InitResources() error {
+	subscriptionID, resourceGroup, _, _ := az.getClientArgs()
+	scope := "/subscriptions/" + subscriptionID
+	definitions, err := az.listResources(scope)
+	if err != nil {
+		return err
+	}
+	for _, definition := range definitions {
+		if resourceGroup != "" && !assignableInResourceGroups(definition, subscriptionID, strings.Split(resourceGroup, ",")) {
+			continue
+		}
+		name := *definition.Name
+		if definition.RoleDefinitionProperties != nil && definition.RoleName != nil {
+			name = *definition.RoleName
+		}
+		// the provider imports role definitions as "<role definition ID>|<scope>"
+		az.AppendSimpleResource(*definition.ID+"|"+scope, name, "azurerm_role_definition")
+	}
+	return nil
+}
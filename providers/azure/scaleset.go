@@ -17,56 +17,75 @@ package azure
 import (
 	"context"
 	"log"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-12-01/compute"
-	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
-	"github.com/hashicorp/go-azure-helpers/authentication"
 )
 
 type ScaleSetGenerator struct {
 	AzureService
 }
 
-func (g ScaleSetGenerator) createResourcesByResourceGroup(ctx context.Context, client compute.VirtualMachineScaleSetsClient, rg string) ([]terraformutils.Resource, error) {
-	scaleSetIterator, err := client.ListComplete(ctx, rg)
-	if err != nil {
-		return nil, err
+// scaleSetResourceType returns the resource type of a scale set by its orchestration mode and OS
+func scaleSetResourceType(scaleSet compute.VirtualMachineScaleSet) string {
+	properties := scaleSet.VirtualMachineScaleSetProperties
+	if properties == nil {
+		return "azurerm_linux_virtual_machine_scale_set"
+	}
+	if properties.OrchestrationMode == compute.OrchestrationModeFlexible {
+		return "azurerm_orchestrated_virtual_machine_scale_set"
+	}
+	profile := properties.VirtualMachineProfile
+	if profile != nil {
+		if profile.OsProfile != nil && profile.OsProfile.WindowsConfiguration != nil {
+			return "azurerm_windows_virtual_machine_scale_set"
+		}
+		if profile.StorageProfile != nil && profile.StorageProfile.OsDisk != nil && profile.StorageProfile.OsDisk.OsType == compute.OperatingSystemTypesWindows {
+			return "azurerm_windows_virtual_machine_scale_set"
+		}
 	}
+	return "azurerm_linux_virtual_machine_scale_set"
+}
+
+func (g ScaleSetGenerator) createResources(ctx context.Context, extensionsClient compute.VirtualMachineScaleSetExtensionsClient, scaleSets []compute.VirtualMachineScaleSet) []terraformutils.Resource {
 	var resources []terraformutils.Resource
-	for scaleSetIterator.NotDone() {
-		scaleSet := scaleSetIterator.Value()
-		newResource := terraformutils.NewSimpleResource(
+	for _, scaleSet := range scaleSets {
+		resources = append(resources, terraformutils.NewSimpleResource(
 			*scaleSet.ID,
 			*scaleSet.Name,
-			"azurerm_virtual_machine_scale_set",
+			scaleSetResourceType(scaleSet),
 			"azurerm",
-			[]string{})
-		resources = append(resources, newResource)
-		if err := scaleSetIterator.Next(); err != nil {
+			[]string{}))
+		extensions, err := g.createExtensionResources(ctx, extensionsClient, scaleSet)
+		if err != nil {
 			log.Println(err)
-			return resources, err
 		}
+		resources = append(resources, extensions...)
 	}
-	return resources, nil
+	return resources
 }
 
-func (g ScaleSetGenerator) createResources(ctx context.Context, client compute.VirtualMachineScaleSetsClient) ([]terraformutils.Resource, error) {
-	scaleSetIterator, err := client.ListAllComplete(ctx)
+func (g ScaleSetGenerator) createExtensionResources(ctx context.Context, client compute.VirtualMachineScaleSetExtensionsClient, scaleSet compute.VirtualMachineScaleSet) ([]terraformutils.Resource, error) {
+	id, err := ParseAzureResourceID(*scaleSet.ID)
+	if err != nil {
+		return nil, err
+	}
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, *scaleSet.Name)
 	if err != nil {
 		return nil, err
 	}
 	var resources []terraformutils.Resource
-	for scaleSetIterator.NotDone() {
-		scaleSet := scaleSetIterator.Value()
-		newResource := terraformutils.NewSimpleResource(
-			*scaleSet.ID,
-			*scaleSet.Name,
-			"azurerm_virtual_machine_scale_set",
+	for iterator.NotDone() {
+		extension := iterator.Value()
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*extension.ID,
+			*scaleSet.Name+"_"+*extension.Name,
+			"azurerm_virtual_machine_scale_set_extension",
 			"azurerm",
-			[]string{})
-		resources = append(resources, newResource)
-		if err := scaleSetIterator.Next(); err != nil {
+			[]string{}))
+		if err := iterator.NextWithContext(ctx); err != nil {
 			log.Println(err)
 			return resources, err
 		}
@@ -74,20 +93,105 @@ func (g ScaleSetGenerator) createResources(ctx context.Context, client compute.V
 	return resources, nil
 }
 
+// createAutoscaleResources imports the autoscale settings targeting one of the imported scale sets
+func (g ScaleSetGenerator) createAutoscaleResources(ctx context.Context, iterator insights.AutoscaleSettingResourceCollectionIterator) ([]terraformutils.Resource, error) {
+	scaleSetIDs := map[string]bool{}
+	for _, r := range g.Resources {
+		if strings.HasSuffix(r.InstanceInfo.Type, "_virtual_machine_scale_set") {
+			scaleSetIDs[strings.ToLower(r.InstanceState.ID)] = true
+		}
+	}
+	var resources []terraformutils.Resource
+	for iterator.NotDone() {
+		setting := iterator.Value()
+		if setting.AutoscaleSetting != nil && setting.TargetResourceURI != nil && scaleSetIDs[strings.ToLower(*setting.TargetResourceURI)] {
+			resources = append(resources, terraformutils.NewSimpleResource(
+				*setting.ID,
+				*setting.Name,
+				"azurerm_monitor_autoscale_setting",
+				"azurerm",
+				[]string{}))
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func listScaleSets(ctx context.Context, client compute.VirtualMachineScaleSetsClient, rgName string) ([]compute.VirtualMachineScaleSet, error) {
+	var scaleSets []compute.VirtualMachineScaleSet
+	if rgName == "" {
+		iterator, err := client.ListAllComplete(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for iterator.NotDone() {
+			scaleSets = append(scaleSets, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return scaleSets, err
+			}
+		}
+		return scaleSets, nil
+	}
+	iterator, err := client.ListComplete(ctx, rgName)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		scaleSets = append(scaleSets, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return scaleSets, err
+		}
+	}
+	return scaleSets, nil
+}
+
 func (g *ScaleSetGenerator) InitResources() error {
 	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	ScaleSetClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
+	scaleSetClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	scaleSetClient.Authorizer = authorizer
+	extensionsClient := compute.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	extensionsClient.Authorizer = authorizer
+	autoscaleClient := insights.NewAutoscaleSettingsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	autoscaleClient.Authorizer = authorizer
 
-	ScaleSetClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
-
-	if rg := g.Args["resource_group"].(string); rg != "" {
-		var err error
-		g.Resources, err = g.createResourcesByResourceGroup(ctx, ScaleSetClient, rg)
-		return err
+	for _, rgName := range g.resourceGroups() {
+		scaleSets, err := listScaleSets(ctx, scaleSetClient, rgName)
+		if err != nil {
+			return err
+		}
+		g.Resources = append(g.Resources, g.createResources(ctx, extensionsClient, scaleSets)...)
 	}
-	var err error
-	g.Resources, err = g.createResources(ctx, ScaleSetClient)
-	return err
+	// autoscale settings are listed after all scale sets so they can target one in another listed group
+	for _, rgName := range g.resourceGroups() {
+		var (
+			autoscaleIterator insights.AutoscaleSettingResourceCollectionIterator
+			err               error
+		)
+		if rgName != "" {
+			autoscaleIterator, err = autoscaleClient.ListByResourceGroupComplete(ctx, rgName)
+		} else {
+			autoscaleIterator, err = autoscaleClient.ListBySubscriptionComplete(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		resources, err := g.createAutoscaleResources(ctx, autoscaleIterator)
+		g.Resources = append(g.Resources, resources...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook references the imported scale sets from their extensions and autoscale settings
+func (g *ScaleSetGenerator) PostConvertHook() error {
+	g.linkResourceIDs("virtual_machine_scale_set_id", "target_resource_id")
+	return nil
 }
@@ -0,0 +1,226 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+)
+
+// Created by Azure along with their parent
+const (
+	serviceBusRootAuthorizationRule = "RootManageSharedAccessKey"
+	serviceBusDefaultRule           = "$Default"
+)
+
+type ServiceBusGenerator struct {
+	AzureService
+}
+
+func (az *ServiceBusGenerator) listNamespaces() ([]servicebus.SBNamespace, error) {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := servicebus.NewNamespacesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var iterators []servicebus.SBNamespaceListResultIterator
+	if resourceGroup != "" {
+		for _, rgName := range strings.Split(resourceGroup, ",") {
+			iterator, err := client.ListByResourceGroupComplete(ctx, strings.TrimSpace(rgName))
+			if err != nil {
+				return nil, err
+			}
+			iterators = append(iterators, iterator)
+		}
+	} else {
+		iterator, err := client.ListComplete(ctx)
+		if err != nil {
+			return nil, err
+		}
+		iterators = append(iterators, iterator)
+	}
+	var resources []servicebus.SBNamespace
+	for _, iterator := range iterators {
+		for iterator.NotDone() {
+			resources = append(resources, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return resources, err
+			}
+		}
+	}
+	return resources, nil
+}
+
+func (az *ServiceBusGenerator) appendAuthorizationRules(iterator servicebus.SBAuthorizationRuleListResultIterator, parentName, resourceType string) error {
+	ctx := context.Background()
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if *item.Name != serviceBusRootAuthorizationRule {
+			az.AppendSimpleResource(*item.ID, parentName+"_"+*item.Name, resourceType)
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *ServiceBusGenerator) appendQueues(resourceGroup, namespaceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := servicebus.NewQueuesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByNamespaceComplete(ctx, resourceGroup, namespaceName, nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		queueName := namespaceName + "_" + *item.Name
+		az.AppendSimpleResource(*item.ID, queueName, "azurerm_servicebus_queue")
+		rules, err := client.ListAuthorizationRulesComplete(ctx, resourceGroup, namespaceName, *item.Name)
+		if err != nil {
+			return err
+		}
+		if err := az.appendAuthorizationRules(rules, queueName, "azurerm_servicebus_queue_authorization_rule"); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *ServiceBusGenerator) appendSubscriptionRules(resourceGroup, namespaceName, topicName, subscriptionName, parentName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := servicebus.NewRulesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListBySubscriptionsComplete(ctx, resourceGroup, namespaceName, topicName, subscriptionName, nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if *item.Name != serviceBusDefaultRule {
+			az.AppendSimpleResource(*item.ID, parentName+"_"+*item.Name, "azurerm_servicebus_subscription_rule")
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *ServiceBusGenerator) appendSubscriptions(resourceGroup, namespaceName, topicName, parentName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := servicebus.NewSubscriptionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByTopicComplete(ctx, resourceGroup, namespaceName, topicName, nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		subscriptionName := parentName + "_" + *item.Name
+		az.AppendSimpleResource(*item.ID, subscriptionName, "azurerm_servicebus_subscription")
+		if err := az.appendSubscriptionRules(resourceGroup, namespaceName, topicName, *item.Name, subscriptionName); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *ServiceBusGenerator) appendTopics(resourceGroup, namespaceName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := servicebus.NewTopicsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListByNamespaceComplete(ctx, resourceGroup, namespaceName, nil, nil)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		topicName := namespaceName + "_" + *item.Name
+		az.AppendSimpleResource(*item.ID, topicName, "azurerm_servicebus_topic")
+		rules, err := client.ListAuthorizationRulesComplete(ctx, resourceGroup, namespaceName, *item.Name)
+		if err != nil {
+			return err
+		}
+		if err := az.appendAuthorizationRules(rules, topicName, "azurerm_servicebus_topic_authorization_rule"); err != nil {
+			return err
+		}
+		if err := az.appendSubscriptions(resourceGroup, namespaceName, *item.Name, topicName); err != nil {
+			return err
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *ServiceBusGenerator) InitResources() error {
+	namespaces, err := az.listNamespaces()
+	if err != nil {
+		return err
+	}
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := servicebus.NewNamespacesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	for _, namespace := range namespaces {
+		az.AppendSimpleResource(*namespace.ID, *namespace.Name, "azurerm_servicebus_namespace")
+		id, err := ParseAzureResourceID(*namespace.ID)
+		if err != nil {
+			return err
+		}
+		rules, err := client.ListAuthorizationRulesComplete(ctx, id.ResourceGroup, *namespace.Name)
+		if err != nil {
+			return err
+		}
+		if err := az.appendAuthorizationRules(rules, *namespace.Name, "azurerm_servicebus_namespace_authorization_rule"); err != nil {
+			return err
+		}
+		if err := az.appendQueues(id.ResourceGroup, *namespace.Name); err != nil {
+			return err
+		}
+		if err := az.appendTopics(id.ResourceGroup, *namespace.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links queues, topics, subscriptions and rules to their imported parent
+func (az *ServiceBusGenerator) PostConvertHook() error {
+	az.linkResourceIDs("namespace_id", "queue_id", "topic_id", "subscription_id")
+	return nil
+}
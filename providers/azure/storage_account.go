@@ -17,69 +17,156 @@ package azure
 import (
 	"context"
 	"log"
+	"net/http"
+	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
-	"github.com/Azure/go-autorest/autorest"
-	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
-	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
 )
 
 type StorageAccountGenerator struct {
 	AzureService
 }
 
-func (g StorageAccountGenerator) createResourcesByResourceGroup(ctx context.Context, client storage.AccountsClient, rg string) ([]terraformutils.Resource, error) {
-	accountListResult, err := client.ListByResourceGroup(ctx, rg)
-	if err != nil {
-		return nil, err
+// listStorageAccounts returns the storage accounts of the subscription or of the resource groups
+func (az *AzureService) listStorageAccounts() ([]storage.Account, error) {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := storage.NewAccountsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var iterators []storage.AccountListResultIterator
+	if resourceGroup != "" {
+		for _, rgName := range strings.Split(resourceGroup, ",") {
+			iterator, err := client.ListByResourceGroupComplete(ctx, strings.TrimSpace(rgName))
+			if err != nil {
+				return nil, err
+			}
+			iterators = append(iterators, iterator)
+		}
+	} else {
+		iterator, err := client.ListComplete(ctx)
+		if err != nil {
+			return nil, err
+		}
+		iterators = append(iterators, iterator)
+	}
+	var accounts []storage.Account
+	for _, iterator := range iterators {
+		for iterator.NotDone() {
+			accounts = append(accounts, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				log.Println(err)
+				return accounts, err
+			}
+		}
+	}
+	return accounts, nil
+}
+
+// storageEndpointID returns the data plane ID of a queue, table, share or directory,
+// or "" when the account has no endpoint for the service
+func storageEndpointID(endpoint *string, path string) string {
+	if endpoint == nil || *endpoint == "" {
+		return ""
 	}
-	var resources []terraformutils.Resource
-	if accounts := accountListResult.Value; accounts != nil {
-		for _, account := range *accounts {
-			resources = append(resources, terraformutils.NewSimpleResource(
-				*account.ID,
-				*account.Name,
-				"azurerm_storage_account",
-				"azurerm",
-				[]string{}))
+	return strings.TrimSuffix(*endpoint, "/") + "/" + path
+}
+
+// hasNetworkRules reports whether the account restricts network access
+func hasNetworkRules(ruleSet *storage.NetworkRuleSet) bool {
+	if ruleSet == nil {
+		return false
+	}
+	return ruleSet.DefaultAction == storage.DefaultActionDeny ||
+		(ruleSet.IPRules != nil && len(*ruleSet.IPRules) > 0) ||
+		(ruleSet.VirtualNetworkRules != nil && len(*ruleSet.VirtualNetworkRules) > 0) ||
+		(ruleSet.ResourceAccessRules != nil && len(*ruleSet.ResourceAccessRules) > 0)
+}
+
+func (az *StorageAccountGenerator) appendManagementPolicy(account storage.Account, resourceGroup string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := storage.NewManagementPoliciesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	policy, err := client.Get(context.Background(), resourceGroup, *account.Name)
+	if err != nil {
+		if policy.Response.Response != nil && policy.StatusCode == http.StatusNotFound {
+			return nil
 		}
+		return err
 	}
-	return resources, nil
+	az.AppendSimpleResource(*policy.ID, *account.Name, "azurerm_storage_management_policy")
+	return nil
 }
-func (g StorageAccountGenerator) createResources(ctx context.Context, client storage.AccountsClient) ([]terraformutils.Resource, error) {
-	accountListResultIterator, err := client.ListComplete(ctx)
+
+func (az *StorageAccountGenerator) appendEncryptionScopes(account storage.Account, resourceGroup string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := storage.NewEncryptionScopesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, resourceGroup, *account.Name)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	var resources []terraformutils.Resource
-	for accountListResultIterator.NotDone() {
-		account := accountListResultIterator.Value()
-		resources = append(resources, terraformutils.NewSimpleResource(
-			*account.ID,
-			*account.Name,
-			"azurerm_storage_account",
-			"azurerm",
-			[]string{}))
-		if err := accountListResultIterator.Next(); err != nil {
+	for iterator.NotDone() {
+		scope := iterator.Value()
+		az.AppendSimpleResource(*scope.ID, *account.Name+"_"+*scope.Name, "azurerm_storage_encryption_scope")
+		if err := iterator.NextWithContext(ctx); err != nil {
 			log.Println(err)
-			return resources, err
+			return err
 		}
 	}
-	return resources, nil
+	return nil
 }
 
-func (g *StorageAccountGenerator) InitResources() error {
-	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	accountsClient := storage.NewAccountsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
-	accountsClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
-	if rg := g.Args["resource_group"].(string); rg != "" {
-		output, err := g.createResourcesByResourceGroup(ctx, accountsClient, rg)
-		g.Resources = output
+func (az *StorageAccountGenerator) InitResources() error {
+	accounts, err := az.listStorageAccounts()
+	if err != nil {
 		return err
 	}
-	output, err := g.createResources(ctx, accountsClient)
-	g.Resources = output
-	return err
+	for _, account := range accounts {
+		az.AppendSimpleResource(*account.ID, *account.Name, "azurerm_storage_account")
+		if account.AccountProperties != nil {
+			// both are imported by the storage account ID
+			if hasNetworkRules(account.NetworkRuleSet) {
+				az.AppendSimpleResource(*account.ID, *account.Name, "azurerm_storage_account_network_rules")
+			}
+			if account.Encryption != nil && account.Encryption.KeySource == storage.KeySourceMicrosoftKeyvault {
+				az.AppendSimpleResource(*account.ID, *account.Name, "azurerm_storage_account_customer_managed_key")
+			}
+		}
+		id, err := ParseAzureResourceID(*account.ID)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if err := az.appendManagementPolicy(account, id.ResourceGroup); err != nil {
+			log.Println(err)
+		}
+		if err := az.appendEncryptionScopes(account, id.ResourceGroup); err != nil {
+			log.Println(err)
+		}
+	}
+	return nil
+}
+
+// PostConvertHook moves network rules and customer managed keys out of the accounts
+// they are imported separately for, and links the account settings to their account
+func (az *StorageAccountGenerator) PostConvertHook() error {
+	separateBlocks := map[string]string{
+		"azurerm_storage_account_network_rules":        "network_rules",
+		"azurerm_storage_account_customer_managed_key": "customer_managed_key",
+	}
+	for _, setting := range az.Resources {
+		block, ok := separateBlocks[setting.InstanceInfo.Type]
+		if !ok {
+			continue
+		}
+		for i, account := range az.Resources {
+			if account.InstanceInfo.Type == "azurerm_storage_account" && strings.EqualFold(account.InstanceState.ID, setting.InstanceState.ID) {
+				delete(az.Resources[i].Item, block)
+			}
+		}
+	}
+	az.linkResourceIDs("storage_account_id")
+	return nil
 }
@@ -12,6 +12,8 @@ import (
 
 const (
 	containerIDFormat = "https://%s.blob.core.windows.net/%s"
+	// Created with the static_website block of the storage account
+	staticWebsiteContainer = "$web"
 )
 
 type StorageContainerGenerator struct {
@@ -109,12 +111,16 @@ func (g *StorageContainerGenerator) getStorageAccounts() ([]storage.Account, err
 }
 
 func (g *StorageContainerGenerator) InitResources() error {
-	storageAccounts, err := g.ListBlobContainers()
+	containers, err := g.ListBlobContainers()
 	if err != nil {
 		return err
 	}
 
-	g.Resources = storageAccounts
+	for _, container := range containers {
+		if container.InstanceState.Attributes["name"] != staticWebsiteContainer {
+			g.Resources = append(g.Resources, container)
+		}
+	}
 
 	return nil
 }
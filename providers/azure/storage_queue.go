@@ -0,0 +1,67 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+)
+
+type StorageQueueGenerator struct {
+	AzureService
+}
+
+// appendQueues lists the queues with the management API, which works without shared keys
+func (az *StorageQueueGenerator) appendQueues(client storage.QueueClient, account storage.Account) error {
+	if account.AccountProperties == nil || account.PrimaryEndpoints == nil || account.PrimaryEndpoints.Queue == nil {
+		return nil
+	}
+	id, err := ParseAzureResourceID(*account.ID)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, *account.Name, "", "")
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		queue := iterator.Value()
+		az.AppendSimpleResource(storageEndpointID(account.PrimaryEndpoints.Queue, *queue.Name), *account.Name+"_"+*queue.Name, "azurerm_storage_queue")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *StorageQueueGenerator) InitResources() error {
+	accounts, err := az.listStorageAccounts()
+	if err != nil {
+		return err
+	}
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := storage.NewQueueClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	for _, account := range accounts {
+		if err := az.appendQueues(client, account); err != nil {
+			log.Println(err)
+		}
+	}
+	return nil
+}
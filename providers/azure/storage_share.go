@@ -0,0 +1,136 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	azstorage "github.com/Azure/azure-sdk-for-go/storage"
+)
+
+type StorageShareGenerator struct {
+	AzureService
+}
+
+// getFileService returns a data plane client for the account, directories can't be
+// listed with the management API. It needs shared key access.
+func (az *StorageShareGenerator) getFileService(account storage.Account, resourceGroup string) (*azstorage.FileServiceClient, error) {
+	if account.AllowSharedKeyAccess != nil && !*account.AllowSharedKeyAccess {
+		return nil, fmt.Errorf("shared key access is disabled on storage account %s, skipping share directories", *account.Name)
+	}
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	accountsClient := storage.NewAccountsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	accountsClient.Authorizer = authorizer
+	keys, err := accountsClient.ListKeys(context.Background(), resourceGroup, *account.Name, "")
+	if err != nil {
+		return nil, err
+	}
+	if keys.Keys == nil || len(*keys.Keys) == 0 {
+		return nil, fmt.Errorf("no access key for storage account %s", *account.Name)
+	}
+	// https://<account>.file.<storage endpoint suffix>/
+	host := strings.TrimPrefix(strings.TrimSuffix(*account.PrimaryEndpoints.File, "/"), "https://")
+	endpointSuffix := strings.TrimPrefix(host, *account.Name+".file.")
+	client, err := azstorage.NewClient(*account.Name, *(*keys.Keys)[0].Value, endpointSuffix, azstorage.DefaultAPIVersion, true)
+	if err != nil {
+		return nil, err
+	}
+	fileService := client.GetFileService()
+	return &fileService, nil
+}
+
+func (az *StorageShareGenerator) appendDirectories(directory *azstorage.Directory, shareID, resourceName, path string) error {
+	params := azstorage.ListDirsAndFilesParameters{}
+	for {
+		response, err := directory.ListDirsAndFiles(params)
+		if err != nil {
+			return err
+		}
+		for _, child := range response.Directories {
+			childPath := path + child.Name
+			az.AppendSimpleResource(shareID+"/"+childPath, resourceName+"_"+childPath, "azurerm_storage_share_directory")
+			if err := az.appendDirectories(directory.GetDirectoryReference(child.Name), shareID, resourceName, childPath+"/"); err != nil {
+				return err
+			}
+		}
+		if response.NextMarker == "" {
+			return nil
+		}
+		params.Marker = response.NextMarker
+	}
+}
+
+// appendShares lists the shares with the management API, which works without shared keys
+func (az *StorageShareGenerator) appendShares(client storage.FileSharesClient, account storage.Account) error {
+	if account.AccountProperties == nil || account.PrimaryEndpoints == nil || account.PrimaryEndpoints.File == nil {
+		return nil
+	}
+	id, err := ParseAzureResourceID(*account.ID)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, *account.Name, "", "", "")
+	if err != nil {
+		return err
+	}
+	var (
+		fileService *azstorage.FileServiceClient
+		fileErr     error
+	)
+	for iterator.NotDone() {
+		share := iterator.Value()
+		shareID := storageEndpointID(account.PrimaryEndpoints.File, *share.Name)
+		resourceName := *account.Name + "_" + *share.Name
+		az.AppendSimpleResource(shareID, resourceName, "azurerm_storage_share")
+		if fileService == nil && fileErr == nil {
+			fileService, fileErr = az.getFileService(account, id.ResourceGroup)
+			if fileErr != nil {
+				log.Println(fileErr)
+			}
+		}
+		if fileService != nil {
+			root := fileService.GetShareReference(*share.Name).GetRootDirectoryReference()
+			if err := az.appendDirectories(root, shareID, resourceName, ""); err != nil {
+				log.Println(err)
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *StorageShareGenerator) InitResources() error {
+	accounts, err := az.listStorageAccounts()
+	if err != nil {
+		return err
+	}
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := storage.NewFileSharesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	for _, account := range accounts {
+		if err := az.appendShares(client, account); err != nil {
+			log.Println(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+)
+
+type StorageTableGenerator struct {
+	AzureService
+}
+
+// appendTables lists the tables with the management API, which works without shared keys
+func (az *StorageTableGenerator) appendTables(client storage.TableClient, account storage.Account) error {
+	if account.AccountProperties == nil || account.PrimaryEndpoints == nil || account.PrimaryEndpoints.Table == nil {
+		return nil
+	}
+	id, err := ParseAzureResourceID(*account.ID)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	iterator, err := client.ListComplete(ctx, id.ResourceGroup, *account.Name)
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		table := iterator.Value()
+		// the provider imports tables as "https://<account>.table.core.windows.net/Tables('<name>')"
+		tableID := storageEndpointID(account.PrimaryEndpoints.Table, fmt.Sprintf("Tables('%s')", *table.Name))
+		az.AppendSimpleResource(tableID, *account.Name+"_"+*table.Name, "azurerm_storage_table")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (az *StorageTableGenerator) InitResources() error {
+	accounts, err := az.listStorageAccounts()
+	if err != nil {
+		return err
+	}
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := storage.NewTableClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	for _, account := range accounts {
+		if err := az.appendTables(client, account); err != nil {
+			log.Println(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-08-01/trafficmanager"
+)
+
+// Terraform resource types of the Traffic Manager endpoint types
+var trafficManagerEndpointTypes = map[string]string{
+	"microsoft.network/trafficmanagerprofiles/azureendpoints":    "azurerm_traffic_manager_azure_endpoint",
+	"microsoft.network/trafficmanagerprofiles/externalendpoints": "azurerm_traffic_manager_external_endpoint",
+	"microsoft.network/trafficmanagerprofiles/nestedendpoints":   "azurerm_traffic_manager_nested_endpoint",
+}
+
+type TrafficManagerGenerator struct {
+	AzureService
+}
+
+// appendProfiles appends the profiles and their endpoints, which are returned with the profile
+func (az *TrafficManagerGenerator) appendProfiles(rgName string) error {
+	subscriptionID, _, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := trafficmanager.NewProfilesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var (
+		result trafficmanager.ProfileListResult
+		err    error
+	)
+	if rgName == "" {
+		result, err = client.ListBySubscription(ctx)
+	} else {
+		result, err = client.ListByResourceGroup(ctx, rgName)
+	}
+	if err != nil {
+		return err
+	}
+	if result.Value == nil {
+		return nil
+	}
+	for _, profile := range *result.Value {
+		az.AppendSimpleResource(*profile.ID, *profile.Name, "azurerm_traffic_manager_profile")
+		if profile.ProfileProperties == nil || profile.Endpoints == nil {
+			continue
+		}
+		for _, endpoint := range *profile.Endpoints {
+			if endpoint.Type == nil {
+				continue
+			}
+			if resourceType, ok := trafficManagerEndpointTypes[strings.ToLower(*endpoint.Type)]; ok {
+				az.AppendSimpleResource(*endpoint.ID, *profile.Name+"_"+*endpoint.Name, resourceType)
+			}
+		}
+	}
+	return nil
+}
+
+func (az *TrafficManagerGenerator) InitResources() error {
+	for _, rgName := range az.resourceGroups() {
+		if err := az.appendProfiles(rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook links the endpoints to their profile, and nested endpoints to
+// the child profile when it is imported too
+func (az *TrafficManagerGenerator) PostConvertHook() error {
+	az.linkResourceIDs("profile_id", "target_resource_id")
+	return nil
+}
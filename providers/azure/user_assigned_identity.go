@@ -0,0 +1,99 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+)
+
+type UserAssignedIdentityGenerator struct {
+	AzureService
+}
+
+func (az *UserAssignedIdentityGenerator) listResources(iterator msi.UserAssignedIdentitiesListResultIterator) ([]msi.Identity, error) {
+	ctx := context.Background()
+	var resources []msi.Identity
+	for iterator.NotDone() {
+		resources = append(resources, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return resources, err
+		}
+	}
+	return resources, nil
+}
+
+func (az *UserAssignedIdentityGenerator) appendResource(identity msi.Identity) {
+	id, err := ParseAzureResourceID(*identity.ID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	az.AppendSimpleResource(*identity.ID, id.ResourceGroup+"_"+*identity.Name, "azurerm_user_assigned_identity")
+
+	// the vendored SDK predates federated identity credentials
+	credentials, err := az.listARMResources(*identity.ID+"/federatedIdentityCredentials", "2023-01-31")
+	if err != nil {
+		log.Println(err)
+	}
+	for _, credential := range credentials {
+		az.AppendSimpleResource(credential.ID, id.ResourceGroup+"_"+*identity.Name+"_"+credential.Name, "azurerm_federated_identity_credential")
+	}
+}
+
+func (az *UserAssignedIdentityGenerator) InitResources() error {
+	subscriptionID, resourceGroup, authorizer, resourceManagerEndpoint := az.getClientArgs()
+	client := msi.NewUserAssignedIdentitiesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	client.Authorizer = authorizer
+	ctx := context.Background()
+
+	var identities []msi.Identity
+	if resourceGroup != "" {
+		for _, rgName := range strings.Split(resourceGroup, ",") {
+			iterator, err := client.ListByResourceGroupComplete(ctx, strings.TrimSpace(rgName))
+			if err != nil {
+				return err
+			}
+			resources, err := az.listResources(iterator)
+			if err != nil {
+				return err
+			}
+			identities = append(identities, resources...)
+		}
+	} else {
+		iterator, err := client.ListBySubscriptionComplete(ctx)
+		if err != nil {
+			return err
+		}
+		identities, err = az.listResources(iterator)
+		if err != nil {
+			return err
+		}
+	}
+	for _, identity := range identities {
+		az.appendResource(identity)
+	}
+	return nil
+}
+
+// PostConvertHook references the identity from its federated credentials
+func (az *UserAssignedIdentityGenerator) PostConvertHook() error {
+	az.linkResourceIDs("parent_id")
+	return nil
+}
@@ -19,83 +19,192 @@ import (
 	"log"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-03-01/compute"
-	"github.com/Azure/go-autorest/autorest"
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
-	"github.com/hashicorp/go-azure-helpers/authentication"
 )
 
 type VirtualMachineGenerator struct {
 	AzureService
 }
 
-func (g VirtualMachineGenerator) createResources(virtualMachineListResultIterator compute.VirtualMachineListResultIterator) ([]terraformutils.Resource, error) {
+// vmResourceType returns the resource type of a VM, VMs with unmanaged (VHD) disks
+// can only be managed with the legacy azurerm_virtual_machine
+func vmResourceType(vm compute.VirtualMachine) string {
+	properties := vm.VirtualMachineProperties
+	if properties == nil {
+		return "azurerm_linux_virtual_machine"
+	}
+	var osDisk *compute.OSDisk
+	if properties.StorageProfile != nil {
+		osDisk = properties.StorageProfile.OsDisk
+	}
+	if osDisk != nil && osDisk.Vhd != nil {
+		return "azurerm_virtual_machine"
+	}
+	if properties.OsProfile == nil {
+		if osDisk != nil && osDisk.OsType == compute.Windows {
+			return "azurerm_windows_virtual_machine"
+		}
+		return "azurerm_linux_virtual_machine"
+	}
+	if properties.OsProfile.WindowsConfiguration != nil {
+		return "azurerm_windows_virtual_machine"
+	}
+	return "azurerm_linux_virtual_machine"
+}
+
+func (g VirtualMachineGenerator) createResources(virtualMachineListResultIterator compute.VirtualMachineListResultIterator) ([]terraformutils.Resource, []compute.VirtualMachine, error) {
 	var resources []terraformutils.Resource
+	var vms []compute.VirtualMachine
 	for virtualMachineListResultIterator.NotDone() {
 		vm := virtualMachineListResultIterator.Value()
-		var newResource terraformutils.Resource
-		if vm.VirtualMachineProperties.OsProfile == nil {
-			if vm.VirtualMachineProperties.StorageProfile.OsDisk.OsType == "Windows" {
-				newResource = terraformutils.NewSimpleResource(
-					*vm.ID,
-					*vm.Name,
-					"azurerm_windows_virtual_machine",
-					"azurerm",
-					[]string{})
-			} else {
-				newResource = terraformutils.NewSimpleResource(
-					*vm.ID,
-					*vm.Name,
-					"azurerm_linux_virtual_machine",
-					"azurerm",
-					[]string{})
-			}
-		} else {
-			if vm.VirtualMachineProperties.OsProfile.WindowsConfiguration != nil {
-				newResource = terraformutils.NewSimpleResource(
-					*vm.ID,
-					*vm.Name,
-					"azurerm_windows_virtual_machine",
-					"azurerm",
-					[]string{})
-			} else {
-				newResource = terraformutils.NewSimpleResource(
-					*vm.ID,
-					*vm.Name,
-					"azurerm_linux_virtual_machine",
-					"azurerm",
-					[]string{})
-			}
-		}
-
-		resources = append(resources, newResource)
+		resources = append(resources, terraformutils.NewSimpleResource(
+			*vm.ID,
+			*vm.Name,
+			vmResourceType(vm),
+			"azurerm",
+			[]string{}))
+		vms = append(vms, vm)
 		if err := virtualMachineListResultIterator.Next(); err != nil {
 			log.Println(err)
-			return resources, err
+			return resources, vms, err
 		}
 	}
-	return resources, nil
+	return resources, vms, nil
 }
 
-func (g *VirtualMachineGenerator) InitResources() error {
-	ctx := context.Background()
-	subscriptionID := g.Args["config"].(authentication.Config).SubscriptionID
-	resourceManagerEndpoint := g.Args["config"].(authentication.Config).CustomResourceManagerEndpoint
-	vmClient := compute.NewVirtualMachinesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+// appendDataDiskAttachments imports the managed data disks of a VM, the legacy
+// azurerm_virtual_machine keeps its disks inline
+func (g *VirtualMachineGenerator) appendDataDiskAttachments(vm compute.VirtualMachine) {
+	if vmResourceType(vm) == "azurerm_virtual_machine" || vm.VirtualMachineProperties == nil || vm.StorageProfile == nil || vm.StorageProfile.DataDisks == nil {
+		return
+	}
+	for _, disk := range *vm.StorageProfile.DataDisks {
+		if disk.ManagedDisk == nil || disk.Name == nil {
+			continue
+		}
+		g.AppendSimpleResource(*vm.ID+"/dataDisks/"+*disk.Name, *vm.Name+"_"+*disk.Name, "azurerm_virtual_machine_data_disk_attachment")
+	}
+}
 
-	vmClient.Authorizer = g.Args["authorizer"].(autorest.Authorizer)
+func (g *VirtualMachineGenerator) appendExtensions(client compute.VirtualMachineExtensionsClient, vm compute.VirtualMachine) error {
+	id, err := ParseAzureResourceID(*vm.ID)
+	if err != nil {
+		return err
+	}
+	extensions, err := client.List(context.Background(), id.ResourceGroup, *vm.Name, "")
+	if err != nil {
+		return err
+	}
+	if extensions.Value == nil {
+		return nil
+	}
+	for _, extension := range *extensions.Value {
+		g.AppendSimpleResource(*extension.ID, *vm.Name+"_"+*extension.Name, "azurerm_virtual_machine_extension")
+	}
+	return nil
+}
 
+func (g *VirtualMachineGenerator) appendAvailabilitySets(client compute.AvailabilitySetsClient, resourceGroup string) error {
 	var (
-		output compute.VirtualMachineListResultIterator
-		err    error
+		iterator compute.AvailabilitySetListResultIterator
+		err      error
 	)
-	if rg := g.Args["resource_group"].(string); rg != "" {
-		output, err = vmClient.ListComplete(ctx, rg)
+	ctx := context.Background()
+	if resourceGroup != "" {
+		iterator, err = client.ListComplete(ctx, resourceGroup)
 	} else {
-		output, err = vmClient.ListAllComplete(ctx)
+		iterator, err = client.ListBySubscriptionComplete(ctx, "")
 	}
 	if err != nil {
 		return err
 	}
-	g.Resources, err = g.createResources(output)
-	return err
+	for iterator.NotDone() {
+		availabilitySet := iterator.Value()
+		g.AppendSimpleResource(*availabilitySet.ID, *availabilitySet.Name, "azurerm_availability_set")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *VirtualMachineGenerator) appendProximityPlacementGroups(client compute.ProximityPlacementGroupsClient, resourceGroup string) error {
+	var (
+		iterator compute.ProximityPlacementGroupListResultIterator
+		err      error
+	)
+	ctx := context.Background()
+	if resourceGroup != "" {
+		iterator, err = client.ListByResourceGroupComplete(ctx, resourceGroup)
+	} else {
+		iterator, err = client.ListBySubscriptionComplete(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	for iterator.NotDone() {
+		group := iterator.Value()
+		g.AppendSimpleResource(*group.ID, *group.Name, "azurerm_proximity_placement_group")
+		if err := iterator.NextWithContext(ctx); err != nil {
+			log.Println(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *VirtualMachineGenerator) InitResources() error {
+	ctx := context.Background()
+	subscriptionID, _, authorizer, resourceManagerEndpoint := g.getClientArgs()
+	vmClient := compute.NewVirtualMachinesClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	vmClient.Authorizer = authorizer
+	extensionsClient := compute.NewVirtualMachineExtensionsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	extensionsClient.Authorizer = authorizer
+	availabilitySetsClient := compute.NewAvailabilitySetsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	availabilitySetsClient.Authorizer = authorizer
+	proximityPlacementGroupsClient := compute.NewProximityPlacementGroupsClientWithBaseURI(resourceManagerEndpoint, subscriptionID)
+	proximityPlacementGroupsClient.Authorizer = authorizer
+
+	for _, rgName := range g.resourceGroups() {
+		var (
+			output compute.VirtualMachineListResultIterator
+			err    error
+		)
+		if rgName != "" {
+			output, err = vmClient.ListComplete(ctx, rgName)
+		} else {
+			output, err = vmClient.ListAllComplete(ctx)
+		}
+		if err != nil {
+			return err
+		}
+		resources, vms, err := g.createResources(output)
+		g.Resources = append(g.Resources, resources...)
+		if err != nil {
+			return err
+		}
+
+		for _, vm := range vms {
+			g.appendDataDiskAttachments(vm)
+			if err := g.appendExtensions(extensionsClient, vm); err != nil {
+				log.Println(err)
+			}
+		}
+
+		if err := g.appendAvailabilitySets(availabilitySetsClient, rgName); err != nil {
+			return err
+		}
+		if err := g.appendProximityPlacementGroups(proximityPlacementGroupsClient, rgName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PostConvertHook references VMs, availability sets and proximity placement groups
+// imported in this service instead of hardcoding their IDs
+func (g *VirtualMachineGenerator) PostConvertHook() error {
+	g.linkResourceIDs("virtual_machine_id", "availability_set_id", "proximity_placement_group_id")
+	return nil
 }
@@ -36,6 +36,18 @@ type ProviderWithSource interface {
 	GetSource() string
 }
 
+// ProviderWithLifecycle ships a built-in ignore_changes table (attributes known to
+// drift by resource type pattern) and picks the resources protected against deletion
+type ProviderWithLifecycle interface {
+	GetIgnoreChanges() map[string][]string
+	GetProtectedResources(resources []*Resource) []*Resource
+}
+
+// ProviderWithTransformRules ships default attribute transformation rules (YAML)
+type ProviderWithTransformRules interface {
+	GetTransformRules() []byte
+}
+
 type Provider struct {
 	Service ServiceGenerator
 	Config  cty.Value
@@ -14,6 +14,15 @@
 
 package terraformutils
 
+import "strings"
+
+// ConnectionOutputName returns the name of the output exposing an attribute of a resource
+// to the services connected to it. Flatmap keys like "identity.0.principal_id" are
+// allowed, their dots aren't valid in output names.
+func ConnectionOutputName(resourceType, resourceName, key string) string {
+	return resourceType + "_" + resourceName + "_" + strings.ReplaceAll(key, ".", "_")
+}
+
 func ConnectServices(importResources map[string][]Resource, isServicePath bool, resourceConnections map[string]map[string][]string) map[string][]Resource {
 	for resource, connection := range resourceConnections {
 		if _, exist := importResources[resource]; exist {
@@ -46,7 +55,7 @@ func mapResource(importResources map[string][]Resource, resource string, connect
 			key = resourceToMap.GetIDKey()
 		}
 		mappingResourceAttr := WalkAndGet(key, resourceToMap.InstanceState.Attributes)
-		keyValue := resourceToMap.InstanceInfo.Type + "_" + resourceToMap.ResourceName + "_" + key
+		keyValue := ConnectionOutputName(resourceToMap.InstanceInfo.Type, resourceToMap.ResourceName, key)
 		linkValue := "${data.terraform_remote_state." + k + ".outputs." + keyValue + "}"
 
 		if len(mappingResourceAttr) == 1 {
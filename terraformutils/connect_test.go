@@ -137,6 +137,51 @@ func prepareNoAttrs(id, resourceType string) Resource {
 	return prepare(id, resourceType, map[string]string{}, map[string]interface{}{})
 }
 
+func TestFlatmapAttributeReference(t *testing.T) {
+	cluster := prepare("ID2", "azurerm_kubernetes_cluster", map[string]string{
+		"kubelet_identity.#":           "1",
+		"kubelet_identity.0.object_id": "OBJECT1",
+		"identity.#":                   "1",
+		"identity.0.principal_id":      "PRINCIPAL1",
+	}, map[string]interface{}{})
+	importResources := map[string][]Resource{
+		"role_assignment": {
+			prepare("ID1", "azurerm_role_assignment", map[string]string{
+				"principal_id": "OBJECT1",
+			}, map[string]interface{}{
+				"principal_id": "OBJECT1",
+			}),
+			prepare("ID3", "azurerm_role_assignment", map[string]string{
+				"principal_id": "PRINCIPAL1",
+			}, map[string]interface{}{
+				"principal_id": "PRINCIPAL1",
+			}),
+		},
+		"aks": {cluster},
+	}
+
+	resourceConnections := map[string]map[string][]string{
+		"role_assignment": {
+			"aks": {
+				"principal_id", "kubelet_identity.0.object_id",
+				"principal_id", "identity.0.principal_id",
+			},
+		},
+	}
+	resources := ConnectServices(importResources, true, resourceConnections)
+
+	if !reflect.DeepEqual(resources["role_assignment"][0].Item, map[string]interface{}{
+		"principal_id": "${data.terraform_remote_state.aks.outputs.azurerm_kubernetes_cluster_name-azurerm_kubernetes_cluster_kubelet_identity_0_object_id}",
+	}) {
+		t.Errorf("failed to connect %v", resources["role_assignment"][0].Item)
+	}
+	if !reflect.DeepEqual(resources["role_assignment"][1].Item, map[string]interface{}{
+		"principal_id": "${data.terraform_remote_state.aks.outputs.azurerm_kubernetes_cluster_name-azurerm_kubernetes_cluster_identity_0_principal_id}",
+	}) {
+		t.Errorf("failed to connect %v", resources["role_assignment"][1].Item)
+	}
+}
+
 func prepare(id, resourceType string, attributes map[string]string, attributesParsed map[string]interface{}) Resource {
 	r := NewResource(id, "name-"+resourceType, resourceType, "provider", attributes, []string{}, map[string]interface{}{})
 	r.InstanceState.Attributes["id"] = r.InstanceState.ID
@@ -22,6 +22,7 @@ import (
 	"log"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/hcl/hcl/ast"
@@ -223,6 +224,8 @@ func hclPrint(data interface{}, mapsObjects map[string]struct{}, sort bool) ([]b
 	// log.Print("HCL second: \t", string(formatted))
 	// hack for support terraform 0.13
 	formatted = terraform13Adjustments(formatted)
+	// ignore_changes takes references, not strings
+	formatted = ignoreChangesAdjustments(formatted)
 	// log.Print("HCL third: \t", string(formatted))
 	if err != nil {
 		log.Println("Invalid HCL follows:")
@@ -287,6 +290,60 @@ func terraform13Adjustments(formatted []byte) []byte {
 	return []byte(s)
 }
 
+var ignoreChangesRe = regexp.MustCompile(`ignore_changes\s*=\s*\[`)
+
+func ignoreChangesAdjustments(formatted []byte) []byte {
+	s := string(formatted)
+	var b strings.Builder
+	for {
+		loc := ignoreChangesRe.FindStringIndex(s)
+		if loc == nil {
+			b.WriteString(s)
+			break
+		}
+		b.WriteString(s[:loc[1]])
+		s = s[loc[1]:]
+		// unquote every string of the list up to the closing bracket
+		for len(s) > 0 && s[0] != ']' {
+			if s[0] != '"' {
+				b.WriteByte(s[0])
+				s = s[1:]
+				continue
+			}
+			end := 1
+			for end < len(s) && s[end] != '"' {
+				if s[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(s) {
+				break
+			}
+			reference, err := strconv.Unquote(s[:end+1])
+			if err != nil {
+				reference = s[:end+1]
+			}
+			b.WriteString(reference)
+			s = s[end+1:]
+		}
+	}
+	return []byte(b.String())
+}
+
+var dataFileRe = regexp.MustCompile(`"(?:\$\{)?file\(\\"data/([^"\\]+)\\"\)(?:\})?"`)
+
+// dataFileAdjustments unquotes the file("data/...") calls reading the given data files
+func dataFileAdjustments(formatted []byte, dataFiles map[string]bool) []byte {
+	return dataFileRe.ReplaceAllFunc(formatted, func(match []byte) []byte {
+		fileName := string(dataFileRe.FindSubmatch(match)[1])
+		if !dataFiles[fileName] {
+			return match
+		}
+		return []byte(`file("data/` + fileName + `")`)
+	})
+}
+
 func escapeRune(s string) string {
 	return fmt.Sprintf("-%04X-", s)
 }
@@ -303,8 +360,12 @@ func TfSanitize(name string) string {
 func HclPrintResource(resources []Resource, providerData map[string]interface{}, output string, sort bool) ([]byte, error) {
 	resourcesByType := map[string]map[string]interface{}{}
 	mapsObjects := map[string]struct{}{}
+	dataFiles := map[string]bool{}
 	indexRe := regexp.MustCompile(`\.[0-9]+`)
 	for _, res := range resources {
+		for fileName := range res.DataFileCalls {
+			dataFiles[fileName] = true
+		}
 		r := resourcesByType[res.InstanceInfo.Type]
 		if r == nil {
 			r = make(map[string]interface{})
@@ -340,5 +401,9 @@ func HclPrintResource(resources []Resource, providerData map[string]interface{},
 	if err != nil {
 		return []byte{}, err
 	}
+	// data files are read with file(), not inlined
+	if output == "hcl" && len(dataFiles) > 0 {
+		hclBytes = dataFileAdjustments(hclBytes, dataFiles)
+	}
 	return hclBytes, nil
 }
@@ -52,3 +52,64 @@ func TestPrintResource(t *testing.T) {
 		t.Errorf("failed to parse data %s", string(data))
 	}
 }
+
+func TestPrintLifecycle(t *testing.T) {
+	importResource := prepare("ID1", "type1", map[string]string{}, map[string]interface{}{
+		"node_count": "3",
+		"lifecycle": map[string]interface{}{
+			"ignore_changes":  []string{"node_count", `tags["CreatedBy"]`},
+			"prevent_destroy": true,
+		},
+	})
+	data, err := HclPrintResource([]Resource{importResource}, map[string]interface{}{}, "hcl", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "lifecycle {") {
+		t.Errorf("failed to print lifecycle block %s", string(data))
+	}
+	if !strings.Contains(string(data), `ignore_changes  = [node_count, tags["CreatedBy"]]`) {
+		t.Errorf("failed to print ignore_changes references %s", string(data))
+	}
+	if !strings.Contains(string(data), `node_count = "3"`) {
+		t.Errorf("unexpected unquoting outside of ignore_changes %s", string(data))
+	}
+}
+
+func TestPrintDataFile(t *testing.T) {
+	importResource := prepare("ID1", "type1", map[string]string{}, map[string]interface{}{
+		"xml_content": `file("data/policy.xml")`,
+		"config_json": `${file("data/dashboard.json")}`,
+		"description": `file("notes.txt")`,
+	})
+	importResource.DataFileCalls = map[string]bool{"policy.xml": true, "dashboard.json": true}
+	data, err := HclPrintResource([]Resource{importResource}, map[string]interface{}{}, "hcl", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `xml_content = file("data/policy.xml")`) {
+		t.Errorf("failed to print data file reference %s", string(data))
+	}
+	if !strings.Contains(string(data), `config_json = file("data/dashboard.json")`) {
+		t.Errorf("failed to print interpolated data file reference %s", string(data))
+	}
+	if !strings.Contains(string(data), `description = "file(\"notes.txt\")"`) {
+		t.Errorf("unexpected unquoting outside of data files %s", string(data))
+	}
+}
+
+func TestPrintUnreferencedDataFile(t *testing.T) {
+	// resources that don't list their data file references, like grafana dashboards,
+	// are printed as before
+	importResource := prepare("ID1", "type1", map[string]string{}, map[string]interface{}{
+		"config_json": `file("data/dashboard.json")`,
+	})
+	importResource.DataFiles = map[string][]byte{"dashboard.json": []byte("{}")}
+	data, err := HclPrintResource([]Resource{importResource}, map[string]interface{}{}, "hcl", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `config_json = "file(\"data/dashboard.json\")"`) {
+		t.Errorf("unexpected unquoting of unreferenced data file %s", string(data))
+	}
+}
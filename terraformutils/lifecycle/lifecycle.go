@@ -0,0 +1,137 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lifecycle adds lifecycle blocks to imported resources for attributes
+// managed outside Terraform and for resources protected against deletion.
+package lifecycle
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"gopkg.in/yaml.v2"
+)
+
+type Config struct {
+	// attributes to ignore by resource type pattern (path.Match)
+	IgnoreChanges map[string][]string `yaml:"ignore_changes"`
+	// resource type patterns that always get prevent_destroy
+	PreventDestroy []string `yaml:"prevent_destroy"`
+}
+
+// LoadConfig merges the provider built-in ignore_changes table with userFile,
+// user entries are added to the built-in ones for the same pattern.
+func LoadConfig(provider terraformutils.ProviderGenerator, userFile string) (*Config, error) {
+	config := &Config{IgnoreChanges: map[string][]string{}}
+	if providerWithLifecycle, ok := provider.(terraformutils.ProviderWithLifecycle); ok {
+		for pattern, attributes := range providerWithLifecycle.GetIgnoreChanges() {
+			config.IgnoreChanges[pattern] = append(config.IgnoreChanges[pattern], attributes...)
+		}
+	}
+	if userFile == "" {
+		return config, nil
+	}
+	content, err := ioutil.ReadFile(userFile)
+	if err != nil {
+		return nil, err
+	}
+	userConfig, err := ParseConfig(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", userFile, err)
+	}
+	for pattern, attributes := range userConfig.IgnoreChanges {
+		config.IgnoreChanges[pattern] = append(config.IgnoreChanges[pattern], attributes...)
+	}
+	config.PreventDestroy = append(config.PreventDestroy, userConfig.PreventDestroy...)
+	return config, nil
+}
+
+func ParseConfig(content []byte) (*Config, error) {
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(content, config); err != nil {
+		return nil, err
+	}
+	for pattern := range config.IgnoreChanges {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid resource type pattern %q: %v", pattern, err)
+		}
+	}
+	for _, pattern := range config.PreventDestroy {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid resource type pattern %q: %v", pattern, err)
+		}
+	}
+	return config, nil
+}
+
+func (c *Config) ignoreChanges(resourceType string) []string {
+	seen := map[string]bool{}
+	var attributes []string
+	for pattern, patternAttributes := range c.IgnoreChanges {
+		if ok, _ := path.Match(pattern, resourceType); !ok {
+			continue
+		}
+		for _, attribute := range patternAttributes {
+			if !seen[attribute] {
+				seen[attribute] = true
+				attributes = append(attributes, attribute)
+			}
+		}
+	}
+	sort.Strings(attributes)
+	return attributes
+}
+
+func (c *Config) preventDestroy(resourceType string) bool {
+	for _, pattern := range c.PreventDestroy {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply adds a lifecycle block to every resource with ignored attributes or
+// protected against deletion. When protectLocked is set, the provider decides
+// which imported resources are locked.
+func (c *Config) Apply(provider terraformutils.ProviderGenerator, resources []*terraformutils.Resource, ignoreChanges, protectLocked bool) {
+	protected := map[*terraformutils.Resource]bool{}
+	if protectLocked {
+		if providerWithLifecycle, ok := provider.(terraformutils.ProviderWithLifecycle); ok {
+			for _, r := range providerWithLifecycle.GetProtectedResources(resources) {
+				protected[r] = true
+			}
+		}
+	}
+	for _, r := range resources {
+		if r.Item == nil {
+			continue
+		}
+		lifecycle := map[string]interface{}{}
+		if ignoreChanges {
+			if attributes := c.ignoreChanges(r.InstanceInfo.Type); len(attributes) > 0 {
+				lifecycle["ignore_changes"] = attributes
+			}
+		}
+		if protected[r] || c.preventDestroy(r.InstanceInfo.Type) {
+			lifecycle["prevent_destroy"] = true
+		}
+		if len(lifecycle) > 0 {
+			r.Item["lifecycle"] = lifecycle
+		}
+	}
+}
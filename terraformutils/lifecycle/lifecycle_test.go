@@ -0,0 +1,124 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+const testConfig = `
+ignore_changes:
+  azurerm_kubernetes_cluster_node_pool: [node_count, tags]
+  azurerm_*_virtual_machine_scale_set: [instances]
+prevent_destroy:
+  - azurerm_key_vault
+`
+
+type testProvider struct {
+	terraformutils.Provider
+}
+
+func (p *testProvider) Init(args []string) error { return nil }
+
+func (p *testProvider) GetName() string { return "azurerm" }
+
+func (p *testProvider) GetSupportedService() map[string]terraformutils.ServiceGenerator {
+	return map[string]terraformutils.ServiceGenerator{}
+}
+
+func (p *testProvider) GetProviderData(arg ...string) map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (p *testProvider) GetResourceConnections() map[string]map[string][]string {
+	return map[string]map[string][]string{}
+}
+
+func (p *testProvider) InitService(serviceName string, verbose bool) error { return nil }
+
+func (p *testProvider) GetIgnoreChanges() map[string][]string {
+	return map[string][]string{"azurerm_kubernetes_cluster_node_pool": {"node_count"}}
+}
+
+func (p *testProvider) GetProtectedResources(resources []*terraformutils.Resource) []*terraformutils.Resource {
+	var protected []*terraformutils.Resource
+	for _, r := range resources {
+		if r.InstanceState.ID == "locked" {
+			protected = append(protected, r)
+		}
+	}
+	return protected
+}
+
+func newTestResource(id, resourceType string) *terraformutils.Resource {
+	r := terraformutils.NewSimpleResource(id, id, resourceType, "azurerm", []string{})
+	r.Item = map[string]interface{}{}
+	return &r
+}
+
+func TestApply(t *testing.T) {
+	config, err := ParseConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for pattern, attributes := range (&testProvider{}).GetIgnoreChanges() {
+		config.IgnoreChanges[pattern] = append(config.IgnoreChanges[pattern], attributes...)
+	}
+	pool := newTestResource("pool", "azurerm_kubernetes_cluster_node_pool")
+	vmss := newTestResource("locked", "azurerm_linux_virtual_machine_scale_set")
+	vault := newTestResource("vault", "azurerm_key_vault")
+	rg := newTestResource("rg", "azurerm_resource_group")
+
+	config.Apply(&testProvider{}, []*terraformutils.Resource{pool, vmss, vault, rg}, true, true)
+
+	expected := map[*terraformutils.Resource]interface{}{
+		pool:  map[string]interface{}{"ignore_changes": []string{"node_count", "tags"}},
+		vmss:  map[string]interface{}{"ignore_changes": []string{"instances"}, "prevent_destroy": true},
+		vault: map[string]interface{}{"prevent_destroy": true},
+		rg:    nil,
+	}
+	for r, lifecycle := range expected {
+		if !reflect.DeepEqual(r.Item["lifecycle"], lifecycle) {
+			t.Errorf("%s: unexpected lifecycle %v", r.InstanceState.ID, r.Item["lifecycle"])
+		}
+	}
+}
+
+func TestApplyWithoutIgnoreChanges(t *testing.T) {
+	config, err := ParseConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := newTestResource("locked", "azurerm_kubernetes_cluster_node_pool")
+	config.Apply(&testProvider{}, []*terraformutils.Resource{pool}, false, false)
+	if _, exist := pool.Item["lifecycle"]; exist {
+		t.Errorf("unexpected lifecycle %v", pool.Item["lifecycle"])
+	}
+}
+
+func TestParseInvalidConfig(t *testing.T) {
+	invalidConfigs := map[string]string{
+		"unknown field":   "ignore_change:\n  a: [b]\n",
+		"invalid pattern": "prevent_destroy: [\"[\"]\n",
+	}
+	for name, content := range invalidConfigs {
+		if _, err := ParseConfig([]byte(content)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -35,6 +35,7 @@ import (
 	tfplugin "github.com/hashicorp/terraform/plugin"
 	"github.com/hashicorp/terraform/providers"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/terraform/tfdiags"
 	"github.com/hashicorp/terraform/version"
 )
 
@@ -209,6 +210,17 @@ func (p *ProviderWrapper) Refresh(info *terraform.InstanceInfo, state *terraform
 	return terraform.NewInstanceStateShimmedFromValue(resp.NewState, int(schema.ResourceTypes[info.Type].Version)), nil
 }
 
+// ValidateResourceTypeConfig asks the provider to validate a resource configuration,
+// which covers the checks that can't be expressed in the schema (conflicting or
+// exactly-one-of attribute sets, value validators).
+func (p *ProviderWrapper) ValidateResourceTypeConfig(typeName string, config cty.Value) tfdiags.Diagnostics {
+	resp := p.Provider.ValidateResourceTypeConfig(providers.ValidateResourceTypeConfigRequest{
+		TypeName: typeName,
+		Config:   config,
+	})
+	return resp.Diagnostics
+}
+
 func (p *ProviderWrapper) initProvider(verbose bool) error {
 	providerFilePath, err := getProviderFileName(p.providerName)
 	if err != nil {
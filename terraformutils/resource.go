@@ -38,6 +38,8 @@ type Resource struct {
 	AdditionalFields  map[string]interface{} `json:",omitempty"`
 	SlowQueryRequired bool
 	DataFiles         map[string][]byte
+	SecretVariables   map[string]string `json:",omitempty"` // variable name -> redacted attribute address
+	DataFileCalls     map[string]bool   `json:",omitempty"` // data files read by file() calls in Item, printed unquoted
 }
 
 type ApplicableFilter interface {
@@ -0,0 +1,186 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secrets finds secret values in imported resources and replaces them
+// with sensitive input variables.
+package secrets
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+)
+
+// Values matching any of these are treated as secrets even if the schema doesn't say so
+var secretPatterns = []*regexp.Regexp{
+	// connection strings (storage, service bus, event hub, SQL, redis)
+	regexp.MustCompile(`(?i)(AccountKey|SharedAccessKey|SharedAccessSignature|Password|Pwd)=[^;]+`),
+	// SAS tokens
+	regexp.MustCompile(`[?&]sig=[A-Za-z0-9%+/=]{16,}`),
+	// PEM private keys
+	regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+	// storage account access keys
+	regexp.MustCompile(`^[A-Za-z0-9+/]{86}==$`),
+}
+
+var unsafeVariableChars = regexp.MustCompile(`[^0-9A-Za-z_\-]`)
+
+var indexRe = regexp.MustCompile(`\.[0-9]+`)
+
+type Detector struct {
+	// sensitive attribute paths by resource type, nested block names included
+	sensitive map[string][][]string
+}
+
+func NewDetector(schema *providers.GetSchemaResponse) *Detector {
+	d := &Detector{sensitive: map[string][][]string{}}
+	for resourceType, resourceSchema := range schema.ResourceTypes {
+		if resourceSchema.Block == nil {
+			continue
+		}
+		d.sensitive[resourceType] = sensitivePaths(resourceSchema.Block, nil)
+	}
+	return d
+}
+
+func sensitivePaths(block *configschema.Block, prefix []string) [][]string {
+	var paths [][]string
+	for name, attribute := range block.Attributes {
+		if attribute.Sensitive {
+			paths = append(paths, extend(prefix, name))
+		}
+	}
+	for name, nested := range block.BlockTypes {
+		paths = append(paths, sensitivePaths(&nested.Block, extend(prefix, name))...)
+	}
+	return paths
+}
+
+// IsSecret reports whether a value looks like a secret
+func IsSecret(value string) bool {
+	for _, re := range secretPatterns {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact replaces secrets in the resource body with references to sensitive variables,
+// recorded in resource.SecretVariables, and optionally removes them from the state.
+func (d *Detector) Redact(resource *terraformutils.Resource, redactConfig, stripState bool) {
+	if redactConfig && resource.Item != nil {
+		for _, path := range d.sensitive[resource.InstanceInfo.Type] {
+			d.redactPath(resource, resource.Item, path, nil)
+		}
+		d.redactDetected(resource, resource.Item, nil)
+	}
+	if stripState && resource.InstanceState != nil {
+		d.stripState(resource)
+	}
+}
+
+func (d *Detector) redactPath(resource *terraformutils.Resource, item map[string]interface{}, path []string, parents []string) {
+	key := path[0]
+	value, exist := item[key]
+	if !exist {
+		return
+	}
+	if len(path) == 1 {
+		item[key] = AddVariable(resource, extend(parents, key))
+		return
+	}
+	switch nested := value.(type) {
+	case map[string]interface{}:
+		d.redactPath(resource, nested, path[1:], extend(parents, key))
+	case []interface{}:
+		for i, element := range nested {
+			if m, ok := element.(map[string]interface{}); ok {
+				d.redactPath(resource, m, path[1:], extend(parents, key, strconv.Itoa(i)))
+			}
+		}
+	}
+}
+
+func (d *Detector) redactDetected(resource *terraformutils.Resource, item map[string]interface{}, parents []string) {
+	for key, value := range item {
+		path := extend(parents, key)
+		switch v := value.(type) {
+		case string:
+			if IsSecret(v) {
+				item[key] = AddVariable(resource, path)
+			}
+		case map[string]interface{}:
+			d.redactDetected(resource, v, path)
+		case []interface{}:
+			for i, element := range v {
+				switch e := element.(type) {
+				case string:
+					if IsSecret(e) {
+						v[i] = AddVariable(resource, extend(path, strconv.Itoa(i)))
+					}
+				case map[string]interface{}:
+					d.redactDetected(resource, e, extend(path, strconv.Itoa(i)))
+				}
+			}
+		}
+	}
+}
+
+// stripState blanks secret values but keeps the flatmap counters so the state stays readable
+func (d *Detector) stripState(resource *terraformutils.Resource) {
+	attributes := resource.InstanceState.Attributes
+	for key, value := range attributes {
+		if strings.HasSuffix(key, ".#") || strings.HasSuffix(key, ".%") {
+			continue
+		}
+		if IsSecret(value) {
+			attributes[key] = ""
+			continue
+		}
+		flatKey := indexRe.ReplaceAllString(key, "")
+		for _, path := range d.sensitive[resource.InstanceInfo.Type] {
+			sensitiveKey := strings.Join(path, ".")
+			if flatKey == sensitiveKey || strings.HasPrefix(flatKey, sensitiveKey+".") {
+				attributes[key] = ""
+				break
+			}
+		}
+	}
+}
+
+func extend(path []string, elems ...string) []string {
+	return append(append([]string{}, path...), elems...)
+}
+
+// AddVariable records a sensitive variable for the attribute path and returns its reference
+func AddVariable(resource *terraformutils.Resource, path []string) string {
+	name := VariableName(resource.InstanceInfo.Type, resource.ResourceName, path)
+	if resource.SecretVariables == nil {
+		resource.SecretVariables = map[string]string{}
+	}
+	resource.SecretVariables[name] = resource.InstanceInfo.Type + "." + resource.ResourceName + "." + strings.Join(path, ".")
+	return "${var." + name + "}"
+}
+
+// VariableName returns the name of the variable holding a secret attribute of a resource
+func VariableName(resourceType, resourceName string, path []string) string {
+	name := resourceType + "_" + resourceName + "_" + strings.Join(path, "_")
+	return strings.ToLower(unsafeVariableChars.ReplaceAllString(name, "_"))
+}
@@ -40,6 +40,13 @@ type ServiceGenerator interface {
 	PostRefreshCleanup()
 }
 
+// ServiceWithImportedResources finds its resources from the ones imported by the
+// other services of the same run, like settings attached to them. Such services are
+// initialized last and get the other services' resources before InitResources.
+type ServiceWithImportedResources interface {
+	SetImportedResources(resources []Resource)
+}
+
 type Service struct {
 	Name         string
 	Resources    []Resource
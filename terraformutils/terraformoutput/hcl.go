@@ -17,6 +17,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
@@ -70,18 +71,22 @@ func OutputHclFiles(resources []terraformutils.Resource, provider terraformutils
 		for _, v := range provider.GetResourceConnections() {
 			for k, ids := range v {
 				if (serviceName != "" && k == serviceName) || (serviceName == "" && k == r.ServiceName()) {
-					if _, exist := r.InstanceState.Attributes[ids[1]]; exist {
-						key := ids[1]
-						if ids[1] == "self_link" || ids[1] == "id" {
+					// every other element is the key of the attribute referred to
+					for j := 1; j < len(ids); j += 2 {
+						if _, exist := r.InstanceState.Attributes[ids[j]]; !exist {
+							continue
+						}
+						key := ids[j]
+						if ids[j] == "self_link" || ids[j] == "id" {
 							key = r.GetIDKey()
 						}
-						linkKey := r.InstanceInfo.Type + "_" + r.ResourceName + "_" + key
+						linkKey := terraformutils.ConnectionOutputName(r.InstanceInfo.Type, r.ResourceName, key)
 						outputsByResource[linkKey] = map[string]interface{}{
 							"value": "${" + r.InstanceInfo.Type + "." + r.ResourceName + "." + key + "}",
 						}
 						outputState[linkKey] = &terraform.OutputState{
 							Type:  "string",
-							Value: r.InstanceState.Attributes[ids[1]],
+							Value: r.InstanceState.Attributes[ids[j]],
 						}
 					}
 				}
@@ -117,6 +122,43 @@ func OutputHclFiles(resources []terraformutils.Resource, provider terraformutils
 			}
 		}
 	}
+	return printSecretVariables(resources, path, output, sort)
+}
+
+// printSecretVariables declares the variables replacing redacted secrets and writes
+// an example tfvars file listing the values that must be supplied.
+func printSecretVariables(resources []terraformutils.Resource, path, output string, sortHcl bool) error {
+	secretVariables := map[string]string{}
+	for _, r := range resources {
+		for name, address := range r.SecretVariables {
+			secretVariables[name] = address
+		}
+	}
+	if len(secretVariables) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(secretVariables))
+	variables := map[string]interface{}{}
+	for name, address := range secretVariables {
+		names = append(names, name)
+		variables[name] = map[string]interface{}{
+			"description": "secret value of " + address,
+			"sensitive":   true,
+		}
+	}
+	variablesFile, err := terraformutils.Print(map[string]interface{}{"variable": variables}, map[string]struct{}{}, output, sortHcl)
+	if err != nil {
+		return err
+	}
+	PrintFile(path+"/secrets."+GetFileExtension(output), variablesFile)
+
+	sort.Strings(names)
+	var example strings.Builder
+	for _, name := range names {
+		example.WriteString("# " + secretVariables[name] + "\n")
+		example.WriteString(name + " = \"\"\n")
+	}
+	PrintFile(path+"/secrets.auto.tfvars.example", []byte(example.String()))
 	return nil
 }
 
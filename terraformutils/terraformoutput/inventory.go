@@ -0,0 +1,179 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	InventoryJSON   = "json"
+	InventoryNDJSON = "ndjson"
+	InventoryYAML   = "yaml"
+)
+
+type InventoryResource struct {
+	Type        string                `json:"type" yaml:"type"`
+	Name        string                `json:"name" yaml:"name"`
+	ID          string                `json:"id" yaml:"id"`
+	Provider    string                `json:"provider" yaml:"provider"`
+	Service     string                `json:"service" yaml:"service"`
+	Attributes  map[string]string     `json:"attributes" yaml:"attributes"`
+	Connections []InventoryConnection `json:"connections,omitempty" yaml:"connections,omitempty"`
+}
+
+// InventoryConnection points from an attribute of a resource to the resource it refers to
+type InventoryConnection struct {
+	Attribute string `json:"attribute" yaml:"attribute"`
+	Service   string `json:"service" yaml:"service"`
+	Type      string `json:"type" yaml:"type"`
+	Name      string `json:"name" yaml:"name"`
+	ID        string `json:"id" yaml:"id"`
+}
+
+type Inventory struct {
+	Provider  string              `json:"provider" yaml:"provider"`
+	Resources []InventoryResource `json:"resources" yaml:"resources"`
+}
+
+// NewInventory lists the imported resources sorted by service, type and name,
+// with connections resolved the same way as --connect does for HCL output.
+func NewInventory(providerName string, importedResource map[string][]terraformutils.Resource, resourceConnections map[string]map[string][]string) *Inventory {
+	inventory := &Inventory{Provider: providerName, Resources: []InventoryResource{}}
+	for serviceName, resources := range importedResource {
+		for _, resource := range resources {
+			inventory.Resources = append(inventory.Resources, InventoryResource{
+				Type:        resource.InstanceInfo.Type,
+				Name:        resource.ResourceName,
+				ID:          resource.InstanceState.ID,
+				Provider:    resource.Provider,
+				Service:     serviceName,
+				Attributes:  resource.InstanceState.Attributes,
+				Connections: inventoryConnections(resource, resourceConnections[serviceName], importedResource),
+			})
+		}
+	}
+	sort.Slice(inventory.Resources, func(i, j int) bool {
+		a, b := inventory.Resources[i], inventory.Resources[j]
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Name < b.Name
+	})
+	return inventory
+}
+
+func inventoryConnections(resource terraformutils.Resource, connections map[string][]string, importedResource map[string][]terraformutils.Resource) []InventoryConnection {
+	var result []InventoryConnection
+	for serviceName, connectionPairs := range connections {
+		if len(connectionPairs)%2 == 1 {
+			continue
+		}
+		for i := 0; i < len(connectionPairs)/2; i++ {
+			attribute, targetKey := connectionPairs[i*2], connectionPairs[i*2+1]
+			values := terraformutils.WalkAndGet(attribute, resource.Item)
+			for _, target := range importedResource[serviceName] {
+				key := targetKey
+				if key == "self_link" || key == "id" {
+					key = target.GetIDKey()
+				}
+				targetValues := terraformutils.WalkAndGet(key, target.InstanceState.Attributes)
+				if len(targetValues) != 1 || !containsValue(values, targetValues[0]) {
+					continue
+				}
+				result = append(result, InventoryConnection{
+					Attribute: attribute,
+					Service:   serviceName,
+					Type:      target.InstanceInfo.Type,
+					Name:      target.ResourceName,
+					ID:        target.InstanceState.ID,
+				})
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Attribute != result[j].Attribute {
+			return result[i].Attribute < result[j].Attribute
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	s, ok := value.(string)
+	if !ok || s == "" {
+		return false
+	}
+	for _, v := range values {
+		if vs, ok := v.(string); ok && strings.EqualFold(vs, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateInventoryFormat checks the format given with --inventory-format
+func ValidateInventoryFormat(format string) error {
+	switch format {
+	case InventoryJSON, InventoryNDJSON, InventoryYAML:
+		return nil
+	}
+	return fmt.Errorf("error: unknown inventory format %s", format)
+}
+
+// Print encodes the inventory as one JSON or YAML document, or one JSON resource per line
+func (i *Inventory) Print(format string) ([]byte, error) {
+	switch format {
+	case InventoryJSON:
+		return json.MarshalIndent(i, "", "  ")
+	case InventoryNDJSON:
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		for _, resource := range i.Resources {
+			if err := encoder.Encode(resource); err != nil {
+				return nil, err
+			}
+		}
+		return buf.Bytes(), nil
+	case InventoryYAML:
+		return yaml.Marshal(i)
+	}
+	return nil, ValidateInventoryFormat(format)
+}
+
+func OutputInventory(inventory *Inventory, path, format string) error {
+	data, err := inventory.Print(format)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(path, "inventory."+format), data, os.ModePerm)
+}
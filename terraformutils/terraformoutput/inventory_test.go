@@ -0,0 +1,97 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformoutput
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+func testInventory() *Inventory {
+	rg := terraformutils.NewResource("/subscriptions/s/resourceGroups/rg1", "rg1", "azurerm_resource_group", "azurerm", map[string]string{
+		"name":     "rg1",
+		"location": "westeurope",
+	}, []string{}, map[string]interface{}{})
+	vnet := terraformutils.NewResource("/subscriptions/s/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet1", "rg1_vnet1", "azurerm_virtual_network", "azurerm", map[string]string{
+		"name":                "vnet1",
+		"resource_group_name": "rg1",
+	}, []string{}, map[string]interface{}{})
+	vnet.Item = map[string]interface{}{"name": "vnet1", "resource_group_name": "RG1"}
+	return NewInventory("azurerm", map[string][]terraformutils.Resource{
+		"virtual_network": {vnet},
+		"resource_group":  {rg},
+	}, map[string]map[string][]string{
+		"virtual_network": {"resource_group": []string{"resource_group_name", "name"}},
+	})
+}
+
+func TestNewInventory(t *testing.T) {
+	inventory := testInventory()
+	if len(inventory.Resources) != 2 {
+		t.Fatalf("expected 2 resources, got %d", len(inventory.Resources))
+	}
+	if inventory.Resources[0].Service != "resource_group" || inventory.Resources[1].Service != "virtual_network" {
+		t.Errorf("resources are not sorted by service %v", inventory.Resources)
+	}
+	expected := []InventoryConnection{{
+		Attribute: "resource_group_name",
+		Service:   "resource_group",
+		Type:      "azurerm_resource_group",
+		Name:      "rg1",
+		ID:        "/subscriptions/s/resourceGroups/rg1",
+	}}
+	if !reflect.DeepEqual(inventory.Resources[1].Connections, expected) {
+		t.Errorf("unexpected connections %v", inventory.Resources[1].Connections)
+	}
+	if inventory.Resources[0].Connections != nil {
+		t.Errorf("unexpected connections %v", inventory.Resources[0].Connections)
+	}
+}
+
+func TestPrintInventory(t *testing.T) {
+	inventory := testInventory()
+	ndjson, err := inventory.Print(InventoryNDJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(ndjson)), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], `{"type":"azurerm_resource_group"`) {
+		t.Errorf("unexpected ndjson %s", ndjson)
+	}
+	yamlData, err := inventory.Print(InventoryYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(yamlData), "- attribute: resource_group_name") {
+		t.Errorf("unexpected yaml %s", yamlData)
+	}
+	if _, err := inventory.Print("xml"); err == nil {
+		t.Error("expected an error for unknown format")
+	}
+}
+
+func TestValidateInventoryFormat(t *testing.T) {
+	for _, format := range []string{InventoryJSON, InventoryNDJSON, InventoryYAML} {
+		if err := ValidateInventoryFormat(format); err != nil {
+			t.Errorf("unexpected error for %s: %v", format, err)
+		}
+	}
+	if err := ValidateInventoryFormat("xml"); err == nil {
+		t.Error("expected an error for unknown format")
+	}
+}
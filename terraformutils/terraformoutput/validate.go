@@ -0,0 +1,156 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package terraformoutput
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils/providerwrapper"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hcldec"
+	"github.com/hashicorp/terraform/configs"
+	"github.com/hashicorp/terraform/lang"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ValidationProblem is a single issue found in a generated file
+type ValidationProblem struct {
+	Filename string
+	Line     int
+	Resource string
+	Summary  string
+	Detail   string
+}
+
+func (p ValidationProblem) String() string {
+	s := fmt.Sprintf("%s:%d: ", p.Filename, p.Line)
+	if p.Resource != "" {
+		s += p.Resource + ": "
+	}
+	s += p.Summary
+	if p.Detail != "" {
+		s += "; " + p.Detail
+	}
+	return s
+}
+
+type resourceConfigValidator func(typeName string, config cty.Value) tfdiags.Diagnostics
+
+// ValidateHclFiles loads the generated files in path with the Terraform config loader
+// and checks every resource body against the provider schema: required attributes
+// and types are checked while decoding, everything else is left to the provider.
+func ValidateHclFiles(path string, providerWrapper *providerwrapper.ProviderWrapper) ([]ValidationProblem, error) {
+	schema := providerWrapper.GetSchema()
+	if schema.Diagnostics.HasErrors() {
+		return nil, schema.Diagnostics.Err()
+	}
+	return validateDir(path, schema.ResourceTypes, providerWrapper.ValidateResourceTypeConfig), nil
+}
+
+func validateDir(path string, resourceTypes map[string]providers.Schema, validate resourceConfigValidator) []ValidationProblem {
+	var problems []ValidationProblem
+	module, diags := configs.NewParser(nil).LoadConfigDir(path)
+	problems = appendHclProblems(problems, "", diags)
+	if module == nil {
+		return problems
+	}
+
+	// references to other resources, remote states and variables can't be resolved
+	// here, so every root name evaluates to an unknown value
+	scope := &lang.Scope{BaseDir: path, PureOnly: true}
+	functions := scope.Functions()
+
+	for _, r := range module.ManagedResources {
+		address := r.Type + "." + r.Name
+		schema, exist := resourceTypes[r.Type]
+		if !exist || schema.Block == nil {
+			problems = append(problems, ValidationProblem{
+				Filename: r.DeclRange.Filename,
+				Line:     r.DeclRange.Start.Line,
+				Resource: address,
+				Summary:  "Unsupported resource type",
+				Detail:   fmt.Sprintf("the provider does not support resource type %q", r.Type),
+			})
+			continue
+		}
+		spec := schema.Block.DecoderSpec()
+		variables := map[string]cty.Value{}
+		for _, traversal := range hcldec.Variables(r.Config, spec) {
+			variables[traversal.RootName()] = cty.DynamicVal
+		}
+		ctx := &hcl.EvalContext{
+			Variables: variables,
+			Functions: functions,
+		}
+		config, decodeDiags := hcldec.Decode(r.Config, spec, ctx)
+		problems = appendHclProblems(problems, address, decodeDiags)
+		if decodeDiags.HasErrors() {
+			continue
+		}
+		for _, diag := range validate(r.Type, config) {
+			if diag.Severity() != tfdiags.Error {
+				continue
+			}
+			problems = append(problems, newValidationProblem(address, r.DeclRange, diag))
+		}
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].Filename != problems[j].Filename {
+			return problems[i].Filename < problems[j].Filename
+		}
+		return problems[i].Line < problems[j].Line
+	})
+	return problems
+}
+
+func appendHclProblems(problems []ValidationProblem, address string, diags hcl.Diagnostics) []ValidationProblem {
+	for _, diag := range diags {
+		if diag.Severity != hcl.DiagError {
+			continue
+		}
+		problem := ValidationProblem{
+			Resource: address,
+			Summary:  diag.Summary,
+			Detail:   diag.Detail,
+		}
+		if diag.Subject != nil {
+			problem.Filename = diag.Subject.Filename
+			problem.Line = diag.Subject.Start.Line
+		}
+		problems = append(problems, problem)
+	}
+	return problems
+}
+
+func newValidationProblem(address string, declRange hcl.Range, diag tfdiags.Diagnostic) ValidationProblem {
+	desc := diag.Description()
+	problem := ValidationProblem{
+		Filename: declRange.Filename,
+		Line:     declRange.Start.Line,
+		Resource: address,
+		Summary:  desc.Summary,
+		Detail:   desc.Detail,
+	}
+	// providers rarely point at the offending attribute, fallback to the resource block
+	if subject := diag.Source().Subject; subject != nil && subject.Filename != "" {
+		problem.Filename = subject.Filename
+		problem.Line = subject.Start.Line
+	}
+	return problem
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package terraformoutput
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/configs/configschema"
+	"github.com/hashicorp/terraform/providers"
+	"github.com/hashicorp/terraform/tfdiags"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const validateTestConfig = `resource "test_thing" "valid" {
+  name  = "valid"
+  other = test_thing.missing_name.id
+}
+
+resource "test_thing" "missing_name" {
+}
+
+resource "test_thing" "wrong_type" {
+  name = "wrong_type"
+  size = "large"
+}
+
+resource "test_thing" "conflict" {
+  name  = "conflict"
+  other = "foo"
+  size  = 1
+}
+
+resource "test_unknown" "unknown" {
+}
+`
+
+func TestValidateDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraformer-validate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "thing.tf"), []byte(validateTestConfig), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	resourceTypes := map[string]providers.Schema{
+		"test_thing": {
+			Block: &configschema.Block{
+				Attributes: map[string]*configschema.Attribute{
+					"id":    {Type: cty.String, Computed: true},
+					"name":  {Type: cty.String, Required: true},
+					"other": {Type: cty.String, Optional: true},
+					"size":  {Type: cty.Number, Optional: true},
+				},
+			},
+		},
+	}
+	validate := func(typeName string, config cty.Value) tfdiags.Diagnostics {
+		var diags tfdiags.Diagnostics
+		if config.GetAttr("other").IsKnown() && !config.GetAttr("other").IsNull() && !config.GetAttr("size").IsNull() {
+			diags = diags.Append(tfdiags.Sourceless(tfdiags.Error, "Conflicting configuration arguments", `"other": conflicts with size`))
+		}
+		return diags
+	}
+
+	problems := validateDir(dir, resourceTypes, validate)
+
+	expected := map[string]int{
+		"test_thing.missing_name": 6,
+		"test_thing.wrong_type":   11,
+		"test_thing.conflict":     14,
+		"test_unknown.unknown":    20,
+	}
+	if len(problems) != len(expected) {
+		t.Fatalf("expected %d problems, got %d: %v", len(expected), len(problems), problems)
+	}
+	for _, problem := range problems {
+		line, exist := expected[problem.Resource]
+		if !exist {
+			t.Errorf("unexpected problem %s", problem)
+			continue
+		}
+		if problem.Line != line {
+			t.Errorf("expected %s to be reported at line %d, got %s", problem.Resource, line, problem)
+		}
+		if filepath.Base(problem.Filename) != "thing.tf" {
+			t.Errorf("expected %s to be reported in thing.tf, got %s", problem.Resource, problem)
+		}
+	}
+}
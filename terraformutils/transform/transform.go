@@ -0,0 +1,273 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package transform applies declarative attribute rules to imported resources,
+// so provider quirks and team conventions can live in YAML instead of generators.
+package transform
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// BeforeRefresh rules see the ID and flatmap attributes the generator created
+	BeforeRefresh = "before_refresh"
+	// AfterRefresh rules see the converted resource body that is printed to HCL
+	AfterRefresh = "after_refresh"
+)
+
+const (
+	ActionRename       = "rename"
+	ActionDrop         = "drop"
+	ActionSet          = "set"
+	ActionRegexReplace = "regex_replace"
+	ActionLowercase    = "lowercase"
+	ActionUppercase    = "uppercase"
+)
+
+// Rule changes one attribute of every resource whose type matches ResourceTypes.
+// Types are path.Match patterns, attributes are dot separated paths.
+type Rule struct {
+	ResourceTypes []string    `yaml:"resource_types"`
+	Phase         string      `yaml:"phase"`
+	Attribute     string      `yaml:"attribute"`
+	Action        string      `yaml:"action"`
+	To            string      `yaml:"to"`
+	Value         interface{} `yaml:"value"`
+	Pattern       string      `yaml:"pattern"`
+	Replacement   string      `yaml:"replacement"`
+
+	re *regexp.Regexp
+}
+
+type Rules struct {
+	Rules []*Rule `yaml:"rules"`
+}
+
+// LoadRules returns the provider default rules followed by the rules in userFile,
+// so user rules run last and can override the defaults.
+func LoadRules(provider terraformutils.ProviderGenerator, userFile string) (*Rules, error) {
+	rules := &Rules{}
+	if providerWithRules, ok := provider.(terraformutils.ProviderWithTransformRules); ok {
+		defaults, err := ParseRules(providerWithRules.GetTransformRules())
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid default transform rules: %v", provider.GetName(), err)
+		}
+		rules.Rules = append(rules.Rules, defaults.Rules...)
+	}
+	if userFile != "" {
+		content, err := ioutil.ReadFile(userFile)
+		if err != nil {
+			return nil, err
+		}
+		userRules, err := ParseRules(content)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", userFile, err)
+		}
+		rules.Rules = append(rules.Rules, userRules.Rules...)
+	}
+	return rules, nil
+}
+
+func ParseRules(content []byte) (*Rules, error) {
+	rules := &Rules{}
+	if err := yaml.UnmarshalStrict(content, rules); err != nil {
+		return nil, err
+	}
+	for i, rule := range rules.Rules {
+		if err := rule.init(); err != nil {
+			return nil, fmt.Errorf("rule %d: %v", i+1, err)
+		}
+	}
+	return rules, nil
+}
+
+func (r *Rule) init() error {
+	if len(r.ResourceTypes) == 0 {
+		return fmt.Errorf("resource_types is required")
+	}
+	for _, pattern := range r.ResourceTypes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid resource type pattern %q: %v", pattern, err)
+		}
+	}
+	if r.Phase == "" {
+		r.Phase = AfterRefresh
+	}
+	if r.Phase != BeforeRefresh && r.Phase != AfterRefresh {
+		return fmt.Errorf("unknown phase %q", r.Phase)
+	}
+	if r.Attribute == "" {
+		return fmt.Errorf("attribute is required")
+	}
+	switch r.Action {
+	case ActionRename:
+		if r.To == "" {
+			return fmt.Errorf("rename of %s requires to", r.Attribute)
+		}
+	case ActionRegexReplace:
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return err
+		}
+		r.re = re
+	case ActionSet:
+		r.Value = normalizeValue(r.Value)
+	case ActionDrop, ActionLowercase, ActionUppercase:
+	default:
+		return fmt.Errorf("unknown action %q", r.Action)
+	}
+	return nil
+}
+
+func (r *Rule) matches(resourceType string) bool {
+	for _, pattern := range r.ResourceTypes {
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply runs every rule of the given phase that matches the resource type
+func (rs *Rules) Apply(phase string, resource *terraformutils.Resource) {
+	if rs == nil {
+		return
+	}
+	for _, rule := range rs.Rules {
+		if rule.Phase != phase || !rule.matches(resource.InstanceInfo.Type) {
+			continue
+		}
+		switch phase {
+		case BeforeRefresh:
+			rule.applyToState(resource)
+		case AfterRefresh:
+			if resource.Item != nil {
+				rule.applyToItem(resource.Item, strings.Split(rule.Attribute, "."))
+			}
+		}
+	}
+}
+
+func (r *Rule) applyToState(resource *terraformutils.Resource) {
+	state := resource.InstanceState
+	if r.Attribute == "id" {
+		state.ID = r.transformString(state.ID)
+	}
+	if state.Attributes == nil {
+		state.Attributes = map[string]string{}
+	}
+	switch r.Action {
+	case ActionRename, ActionDrop:
+		for key, value := range state.Attributes {
+			if key != r.Attribute && !strings.HasPrefix(key, r.Attribute+".") {
+				continue
+			}
+			delete(state.Attributes, key)
+			if r.Action == ActionRename {
+				state.Attributes[r.To+strings.TrimPrefix(key, r.Attribute)] = value
+			}
+		}
+	case ActionSet:
+		state.Attributes[r.Attribute] = fmt.Sprint(r.Value)
+	default:
+		if value, exist := state.Attributes[r.Attribute]; exist {
+			state.Attributes[r.Attribute] = r.transformString(value)
+		}
+	}
+}
+
+func (r *Rule) applyToItem(item map[string]interface{}, attributePath []string) {
+	key := attributePath[0]
+	if len(attributePath) > 1 {
+		switch nested := item[key].(type) {
+		case map[string]interface{}:
+			r.applyToItem(nested, attributePath[1:])
+		case []interface{}:
+			for _, element := range nested {
+				if m, ok := element.(map[string]interface{}); ok {
+					r.applyToItem(m, attributePath[1:])
+				}
+			}
+		}
+		return
+	}
+	switch r.Action {
+	case ActionRename:
+		if value, exist := item[key]; exist {
+			delete(item, key)
+			item[r.To] = value
+		}
+	case ActionDrop:
+		delete(item, key)
+	case ActionSet:
+		item[key] = r.Value
+	default:
+		if value, exist := item[key]; exist {
+			item[key] = r.transformValue(value)
+		}
+	}
+}
+
+func (r *Rule) transformValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return r.transformString(v)
+	case []interface{}:
+		for i := range v {
+			v[i] = r.transformValue(v[i])
+		}
+		return v
+	}
+	return value
+}
+
+func (r *Rule) transformString(value string) string {
+	switch r.Action {
+	case ActionRegexReplace:
+		return r.re.ReplaceAllString(value, r.Replacement)
+	case ActionLowercase:
+		return strings.ToLower(value)
+	case ActionUppercase:
+		return strings.ToUpper(value)
+	case ActionSet:
+		return fmt.Sprint(r.Value)
+	}
+	return value
+}
+
+// yaml.v2 decodes maps as map[interface{}]interface{} which can't be printed as JSON
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			m[fmt.Sprint(key)] = normalizeValue(val)
+		}
+		return m
+	case []interface{}:
+		for i := range v {
+			v[i] = normalizeValue(v[i])
+		}
+		return v
+	}
+	return value
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The Terraformer Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/terraformer/terraformutils"
+)
+
+const testRules = `
+rules:
+  - resource_types: [azurerm_kubernetes_*]
+    phase: before_refresh
+    attribute: id
+    action: regex_replace
+    pattern: /resourcegroups/
+    replacement: /resourceGroups/
+  - resource_types: [azurerm_kubernetes_cluster]
+    phase: before_refresh
+    attribute: old_tags
+    action: rename
+    to: tags
+  - resource_types: [azurerm_kubernetes_cluster]
+    attribute: default_node_pool.node_count
+    action: drop
+  - resource_types: [azurerm_kubernetes_cluster]
+    attribute: location
+    action: lowercase
+  - resource_types: [azurerm_kubernetes_cluster]
+    attribute: tags
+    action: set
+    value:
+      team: platform
+`
+
+func TestApplyRules(t *testing.T) {
+	rules, err := ParseRules([]byte(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource := terraformutils.NewResource(
+		"/subscriptions/s/resourcegroups/rg/providers/Microsoft.ContainerService/managedClusters/aks",
+		"aks",
+		"azurerm_kubernetes_cluster",
+		"azurerm",
+		map[string]string{
+			"old_tags.%":   "1",
+			"old_tags.env": "dev",
+		},
+		[]string{},
+		map[string]interface{}{},
+	)
+
+	rules.Apply(BeforeRefresh, &resource)
+	if resource.InstanceState.ID != "/subscriptions/s/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/aks" {
+		t.Errorf("unexpected id %s", resource.InstanceState.ID)
+	}
+	expectedAttributes := map[string]string{
+		"tags.%":   "1",
+		"tags.env": "dev",
+	}
+	if !reflect.DeepEqual(resource.InstanceState.Attributes, expectedAttributes) {
+		t.Errorf("unexpected attributes %v", resource.InstanceState.Attributes)
+	}
+
+	resource.Item = map[string]interface{}{
+		"location": "WestEurope",
+		"default_node_pool": []interface{}{
+			map[string]interface{}{
+				"name":       "default",
+				"node_count": "3",
+			},
+		},
+	}
+	rules.Apply(AfterRefresh, &resource)
+	expectedItem := map[string]interface{}{
+		"location": "westeurope",
+		"default_node_pool": []interface{}{
+			map[string]interface{}{
+				"name": "default",
+			},
+		},
+		"tags": map[string]interface{}{
+			"team": "platform",
+		},
+	}
+	if !reflect.DeepEqual(resource.Item, expectedItem) {
+		t.Errorf("unexpected item %v", resource.Item)
+	}
+}
+
+func TestRulesNotMatchingType(t *testing.T) {
+	rules, err := ParseRules([]byte(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource := terraformutils.NewSimpleResource("/subscriptions/s/resourcegroups/rg", "rg", "azurerm_resource_group", "azurerm", []string{})
+	rules.Apply(BeforeRefresh, &resource)
+	if resource.InstanceState.ID != "/subscriptions/s/resourcegroups/rg" {
+		t.Errorf("unexpected id %s", resource.InstanceState.ID)
+	}
+}
+
+func TestParseInvalidRules(t *testing.T) {
+	invalidRules := map[string]string{
+		"unknown action": "rules:\n  - resource_types: [a]\n    attribute: b\n    action: upcase\n",
+		"unknown phase":  "rules:\n  - resource_types: [a]\n    attribute: b\n    action: drop\n    phase: later\n",
+		"missing types":  "rules:\n  - attribute: b\n    action: drop\n",
+		"invalid regexp": "rules:\n  - resource_types: [a]\n    attribute: b\n    action: regex_replace\n    pattern: \"(\"\n",
+		"rename no to":   "rules:\n  - resource_types: [a]\n    attribute: b\n    action: rename\n",
+		"unknown field":  "rules:\n  - resource_types: [a]\n    attribute: b\n    action: drop\n    with: c\n",
+	}
+	for name, content := range invalidRules {
+		if _, err := ParseRules([]byte(content)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}